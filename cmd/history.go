@@ -11,16 +11,27 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var historyTag string
+
 // historyCmd represents the history command
 var historyCmd = &cobra.Command{
 	Use:   "history",
 	Short: "Show repository history and statistics",
-	Long:  `Display a formatted overview of your repository's history including branch, commits, tags, and contributors.`,
+	Long: `Display a formatted overview of your repository's history including branch, commits, tags, and contributors.
+
+With --tag the release notes stored in the annotated tag are printed instead:
+
+  neko history --tag v1.2.0`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if historyTag != "" {
+			history.ShowTagNotes(historyTag)
+			return
+		}
 		history.ShowHistory()
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().StringVar(&historyTag, "tag", "", "Print the release notes of an annotated tag")
 }
@@ -13,17 +13,32 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var initOptions initcmd.Options
+
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize neko configuration",
 	Long: `Interactive wizard to set up your project type and release system.
-Neko manages version numbers uniformly across different release systems.`,
+Neko manages version numbers uniformly across different release systems.
+
+All wizard answers can be given as flags. When project type, release system
+and version are known the wizard is skipped entirely:
+
+  neko init --project-type backend --release-system jreleaser --version 1.0.0 --force
+
+With --from-template the .neko.json and all tool configs of an org-wide
+template directory are used as a starting point. Flags take precedence.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		repoInfo, _ := git.Current()
-		initcmd.Run(repoInfo)
+		initcmd.Run(repoInfo, initOptions)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(initCmd)
+	initCmd.Flags().StringVar(&initOptions.ProjectType, "project-type", "", "Project type, skips the project type prompt")
+	initCmd.Flags().StringVar(&initOptions.ReleaseSystem, "release-system", "", "Release system, skips the release system prompt")
+	initCmd.Flags().StringVar(&initOptions.Version, "version", "", "Initial version, skips the version prompt")
+	initCmd.Flags().BoolVar(&initOptions.Force, "force", false, "Overwrite an existing .neko.json and template files")
+	initCmd.Flags().StringVar(&initOptions.Template, "from-template", "", "Template directory, template .neko.json or file:// url")
 }
@@ -35,6 +35,33 @@ var releaseCmd = &cobra.Command{
 	},
 }
 
+// releaseFinalizeCmd tags and publishes a merged release pull request
+var releaseFinalizeCmd = &cobra.Command{
+	Use:   "finalize",
+	Short: "Tag and publish a merged release pull request",
+	Long: `Completes a release of the pull-request strategy. Run it on the base branch
+after the pull request opened by neko release was merged:
+
+  neko release finalize`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		cfg := config.LoadConfig()
+
+		service := release.NewReleaseService(cfg)
+
+		if err := service.Finalize(); err != nil {
+			errors.Fatal(
+				"Release failed",
+				err.Error(),
+				errors.ErrReleaseFailed,
+			)
+		}
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(releaseCmd)
+	releaseCmd.PersistentFlags().StringVar(&release.FreezeOverride, "override-freeze", "", "Release during a freeze window, the justification is recorded in the release commit or the tag of finalize")
+	releaseCmd.AddCommand(releaseFinalizeCmd)
 }
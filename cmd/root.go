@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
 	"github.com/spf13/cobra"
 )
 
@@ -57,4 +58,19 @@ func init() {
 		false,
 		"Enable verbose output",
 	)
+
+	rootCmd.PersistentFlags().BoolVar(
+		&prompt.NonInteractive,
+		"non-interactive",
+		false,
+		"Never prompt, fail when a required answer is missing",
+	)
+
+	rootCmd.PersistentFlags().BoolVarP(
+		&prompt.AssumeYes,
+		"yes",
+		"y",
+		false,
+		"Answer every prompt with its default, questions without a default are confirmed",
+	)
 }
@@ -0,0 +1,122 @@
+// Package changelog generates markdown release notes from the git history
+package changelog
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/nekoman-hq/neko-cli/internal/git"
+)
+
+// conventionalRegex matches conventional commit subjects like "feat(cli)!: add flag"
+var conventionalRegex = regexp.MustCompile(`^(\w+)(?:\(([^)]*)\))?(!)?:\s*(.+)$`)
+
+type section struct {
+	title string
+	types []string
+}
+
+// sections are rendered in order, commits of unknown types end up in "Other Changes"
+var sections = []section{
+	{title: "Breaking Changes"},
+	{title: "Features", types: []string{"feat", "feature"}},
+	{title: "Bug Fixes", types: []string{"fix", "bug", "hotfix"}},
+	{title: "Performance", types: []string{"perf"}},
+	{title: "Refactoring", types: []string{"refactor", "improvement"}},
+	{title: "Documentation", types: []string{"docs"}},
+	{title: "Tests", types: []string{"test"}},
+	{title: "Chores", types: []string{"chore", "build", "ci", "style"}},
+	{title: "Other Changes"},
+}
+
+// releaseCommitPrefix marks commits created by neko itself, they are never listed
+const releaseCommitPrefix = "chore(neko-release)"
+
+// isReleaseCommit reports whether subject starts with the default or the
+// configured release commit prefix
+func isReleaseCommit(subject, ignore string) bool {
+	if strings.HasPrefix(subject, releaseCommitPrefix) {
+		return true
+	}
+	return ignore != "" && strings.HasPrefix(subject, ignore)
+}
+
+// Generate renders the release notes for tag from the commits between from and
+// to. Commits starting with ignore, the prefix of custom release commit
+// messages, are left out like the default release commits.
+func Generate(tag, from, to, ignore string) (string, error) {
+	commits, err := git.CommitsBetween(from, to)
+	if err != nil {
+		return "", err
+	}
+	return Render(tag, commits, ignore), nil
+}
+
+// Render groups the commits by conventional commit type
+func Render(tag string, commits []git.Commit, ignore string) string {
+	grouped := make(map[string][]string)
+
+	for _, c := range commits {
+		if isReleaseCommit(c.Subject, ignore) {
+			continue
+		}
+
+		title, entry := classify(c)
+		grouped[title] = append(grouped[title], entry)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s (%s)\n", tag, time.Now().Format("2006-01-02"))
+
+	if len(grouped) == 0 {
+		b.WriteString("\nNo notable changes.\n")
+		return b.String()
+	}
+
+	for _, s := range sections {
+		entries := grouped[s.title]
+		if len(entries) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n### %s\n\n", s.title)
+		for _, entry := range entries {
+			fmt.Fprintf(&b, "- %s\n", entry)
+		}
+	}
+	return b.String()
+}
+
+func classify(c git.Commit) (string, string) {
+	m := conventionalRegex.FindStringSubmatch(c.Subject)
+	if m == nil {
+		return "Other Changes", fmt.Sprintf("%s (%s)", c.Subject, c.Hash)
+	}
+
+	commitType, scope, breaking, description := strings.ToLower(m[1]), m[2], m[3], m[4]
+	if scope != "" {
+		description = fmt.Sprintf("**%s:** %s", scope, description)
+	}
+	entry := fmt.Sprintf("%s (%s)", description, c.Hash)
+
+	if breaking != "" {
+		return "Breaking Changes", entry
+	}
+
+	for _, s := range sections {
+		for _, t := range s.types {
+			if t == commitType {
+				return s.title, entry
+			}
+		}
+	}
+	return "Other Changes", entry
+}
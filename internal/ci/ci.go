@@ -0,0 +1,90 @@
+// Package ci detects continuous integration environments and exposes their git context
+package ci
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"os"
+	"strings"
+)
+
+// Environment describes the CI provider neko is currently running in
+type Environment struct {
+	Provider string
+	Branch   string
+}
+
+type provider struct {
+	name       string
+	detectVar  string
+	branchVars []string
+}
+
+// providers lists the supported CI systems. The branch variables are checked
+// in order, so pull request variables must come before push variables.
+var providers = []provider{
+	{name: "github-actions", detectVar: "GITHUB_ACTIONS", branchVars: []string{"GITHUB_HEAD_REF", "GITHUB_REF_NAME"}},
+	{name: "gitlab-ci", detectVar: "GITLAB_CI", branchVars: []string{"CI_MERGE_REQUEST_SOURCE_BRANCH_NAME", "CI_COMMIT_BRANCH", "CI_COMMIT_REF_NAME"}},
+	{name: "circleci", detectVar: "CIRCLECI", branchVars: []string{"CIRCLE_BRANCH"}},
+	{name: "bitbucket-pipelines", detectVar: "BITBUCKET_BUILD_NUMBER", branchVars: []string{"BITBUCKET_BRANCH"}},
+	{name: "azure-pipelines", detectVar: "TF_BUILD", branchVars: []string{"SYSTEM_PULLREQUEST_SOURCEBRANCH", "BUILD_SOURCEBRANCH"}},
+	{name: "jenkins", detectVar: "JENKINS_URL", branchVars: []string{"CHANGE_BRANCH", "BRANCH_NAME", "GIT_BRANCH"}},
+	{name: "travis-ci", detectVar: "TRAVIS", branchVars: []string{"TRAVIS_PULL_REQUEST_BRANCH", "TRAVIS_BRANCH"}},
+	{name: "drone", detectVar: "DRONE", branchVars: []string{"DRONE_SOURCE_BRANCH", "DRONE_BRANCH"}},
+	{name: "generic", detectVar: "CI", branchVars: []string{"CI_BRANCH", "BRANCH_NAME"}},
+}
+
+// Detect returns the current CI environment or nil when neko runs locally
+func Detect() *Environment {
+	for _, p := range providers {
+		if !isSet(p.detectVar) {
+			continue
+		}
+
+		return &Environment{
+			Provider: p.name,
+			Branch:   branchFrom(p.branchVars),
+		}
+	}
+	return nil
+}
+
+// IsCI reports whether neko runs inside a known CI environment
+func IsCI() bool {
+	return Detect() != nil
+}
+
+func isSet(name string) bool {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false", "no":
+		return false
+	default:
+		return true
+	}
+}
+
+func branchFrom(vars []string) string {
+	for _, name := range vars {
+		if value := strings.TrimSpace(os.Getenv(name)); value != "" {
+			return normalizeBranch(value)
+		}
+	}
+	return ""
+}
+
+// normalizeBranch strips ref prefixes some providers put in front of the branch name
+func normalizeBranch(ref string) string {
+	for _, prefix := range []string{"refs/heads/", "origin/"} {
+		ref = strings.TrimPrefix(ref, prefix)
+	}
+	return ref
+}
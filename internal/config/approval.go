@@ -0,0 +1,40 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ApprovalConfig controls the confirmation shown before neko release changes anything
+type ApprovalConfig struct {
+	// Checklist items must each be acknowledged before the release starts
+	Checklist []string `json:"checklist,omitempty"`
+}
+
+// ValidateApproval rejects empty checklist items
+func (c *NekoConfig) ValidateApproval() error {
+	if c.Approval == nil {
+		return nil
+	}
+
+	for i, item := range c.Approval.Checklist {
+		if strings.TrimSpace(item) == "" {
+			return fmt.Errorf("checklist item %d is empty", i+1)
+		}
+	}
+	return nil
+}
+
+// ApprovalChecklist returns the configured checklist, nil without approval settings
+func (c *NekoConfig) ApprovalChecklist() []string {
+	if c.Approval == nil {
+		return nil
+	}
+	return c.Approval.Checklist
+}
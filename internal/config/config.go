@@ -8,6 +8,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"regexp"
 
@@ -68,7 +69,7 @@ func Validate(cfg *NekoConfig) {
 		return
 	}
 
-	if !cfg.ReleaseSystem.IsValid() {
+	if !cfg.HasReleaseSystem() {
 		errors.Error(
 			"Invalid configuration",
 			"ReleaseSystem is invalid in .neko.json",
@@ -77,6 +78,78 @@ func Validate(cfg *NekoConfig) {
 		return
 	}
 
+	if !cfg.Profile.IsValid() {
+		errors.Error(
+			"Invalid configuration",
+			"Profile is invalid in .neko.json (valid options: default, ci)",
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if !cfg.ReleaseStrategy.IsValid() {
+		errors.Error(
+			"Invalid configuration",
+			"ReleaseStrategy is invalid in .neko.json (valid options: direct, pull-request)",
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if cfg.Git != nil && !cfg.Git.Backend.IsValid() {
+		errors.Error(
+			"Invalid configuration",
+			"Git backend is invalid in .neko.json (valid options: exec, go-git)",
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if cfg.Git != nil && cfg.Git.Sign && !cfg.ReleaseSystem.SupportsSigning() {
+		errors.Error(
+			"Invalid configuration",
+			fmt.Sprintf("git.sign is not supported by %s, it creates the release tag itself", cfg.ReleaseSystem),
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if cfg.Container != nil && !cfg.Container.IsValid() {
+		errors.Error(
+			"Invalid configuration",
+			"Container is invalid in .neko.json (engines: docker, podman; tags: version, minor, major, latest)",
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if err := cfg.ValidateHooks(); err != nil {
+		errors.Error(
+			"Invalid configuration",
+			"Hooks are invalid in .neko.json: "+err.Error(),
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if err := cfg.ValidateApproval(); err != nil {
+		errors.Error(
+			"Invalid configuration",
+			"Approval is invalid in .neko.json: "+err.Error(),
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
+	if err := cfg.ValidateFreeze(); err != nil {
+		errors.Error(
+			"Invalid configuration",
+			"Freeze is invalid in .neko.json: "+err.Error(),
+			errors.ErrConfigMarshal,
+		)
+		return
+	}
+
 	if cfg.Version == "" {
 		errors.Error(
 			"Invalid configuration",
@@ -0,0 +1,72 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+type ContainerEngine string
+
+const (
+	EngineDocker ContainerEngine = "docker"
+	EnginePodman ContainerEngine = "podman"
+)
+
+// Image tag rules, pre-releases are only ever tagged with TagRuleVersion
+const (
+	TagRuleVersion = "version" // X.Y.Z
+	TagRuleMinor   = "minor"   // X.Y
+	TagRuleMajor   = "major"   // X
+	TagRuleLatest  = "latest"
+)
+
+var TagRules = []string{TagRuleVersion, TagRuleMinor, TagRuleMajor, TagRuleLatest}
+
+// ContainerConfig configures the optional image build after a release
+type ContainerConfig struct {
+	// Engine defaults to docker
+	Engine ContainerEngine `json:"engine,omitempty"`
+	// Registry is prefixed to every image name, e.g. ghcr.io/nekoman-hq
+	Registry string `json:"registry,omitempty"`
+	// Tags are the tag rules applied to stable releases, defaults to all rules
+	Tags   []string `json:"tags,omitempty"`
+	Images []Image  `json:"images"`
+}
+
+type Image struct {
+	// Name defaults to the project name
+	Name string `json:"name,omitempty"`
+	// Dockerfile defaults to Dockerfile
+	Dockerfile string `json:"dockerfile,omitempty"`
+	// Context defaults to the project root
+	Context string `json:"context,omitempty"`
+}
+
+func (e ContainerEngine) IsValid() bool {
+	switch e {
+	case "", EngineDocker, EnginePodman:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *ContainerConfig) IsValid() bool {
+	if !c.Engine.IsValid() {
+		return false
+	}
+
+	for _, tag := range c.Tags {
+		valid := false
+		for _, rule := range TagRules {
+			if tag == rule {
+				valid = true
+			}
+		}
+		if !valid {
+			return false
+		}
+	}
+	return true
+}
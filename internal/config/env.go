@@ -9,6 +9,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/nekoman-hq/neko-cli/internal/errors"
 	"github.com/nekoman-hq/neko-cli/internal/log"
@@ -32,3 +33,23 @@ func GetPAT() string {
 	}
 	return token
 }
+
+// HasPAT reports whether GITHUB_TOKEN is set, for optional API lookups
+func HasPAT() bool {
+	return os.Getenv("GITHUB_TOKEN") != ""
+}
+
+// DefaultAPIURL is the REST endpoint of github.com
+const DefaultAPIURL = "https://api.github.com"
+
+// GetAPIURL returns the forge API base url. NEKO_API_URL points neko at
+// another endpoint, e.g. a local fake server, GITHUB_API_URL is set by
+// GitHub Actions on GitHub Enterprise.
+func GetAPIURL() string {
+	for _, key := range []string{"NEKO_API_URL", "GITHUB_API_URL"} {
+		if value := os.Getenv(key); value != "" {
+			return strings.TrimSuffix(value, "/")
+		}
+	}
+	return DefaultAPIURL
+}
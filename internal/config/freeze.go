@@ -0,0 +1,111 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nekoman-hq/neko-cli/internal/cron"
+)
+
+const freezeDateLayout = "2006-01-02"
+
+// FreezeConfig blocks releases during recurring windows and date ranges
+type FreezeConfig struct {
+	// Timezone is an IANA name like Europe/Vienna, defaults to the local timezone
+	Timezone string `json:"timezone,omitempty"`
+	// Windows are cron expressions, every matching minute is frozen
+	Windows []FreezeWindow `json:"windows,omitempty"`
+	// Ranges are frozen from their start until their end
+	Ranges []FreezeRange `json:"ranges,omitempty"`
+}
+
+// FreezeWindow is a recurring freeze, e.g. "* 14-23 * * FRI" for Friday afternoons
+type FreezeWindow struct {
+	Cron   string `json:"cron"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// FreezeRange is a one-off freeze. From and To are dates (2026-12-24) or
+// RFC 3339 timestamps, a date as To includes the whole day.
+type FreezeRange struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Location returns the timezone the freeze windows are evaluated in
+func (f *FreezeConfig) Location() (*time.Location, error) {
+	if f.Timezone == "" {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(f.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", f.Timezone)
+	}
+	return loc, nil
+}
+
+// Bounds returns the start and the exclusive end of the range
+func (r FreezeRange) Bounds(loc *time.Location) (time.Time, time.Time, error) {
+	from, _, err := parseFreezeTime(r.From, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid from %q: %w", r.From, err)
+	}
+
+	to, dateOnly, err := parseFreezeTime(r.To, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid to %q: %w", r.To, err)
+	}
+	if dateOnly {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("range %s to %s ends before it starts", r.From, r.To)
+	}
+	return from, to, nil
+}
+
+func parseFreezeTime(value string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(freezeDateLayout, value, loc); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("use YYYY-MM-DD or an RFC 3339 timestamp")
+	}
+	return t, false, nil
+}
+
+// ValidateFreeze checks the timezone, cron expressions and ranges
+func (c *NekoConfig) ValidateFreeze() error {
+	if c.Freeze == nil {
+		return nil
+	}
+
+	loc, err := c.Freeze.Location()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range c.Freeze.Windows {
+		if _, err := cron.Parse(w.Cron); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range c.Freeze.Ranges {
+		if _, _, err := r.Bounds(loc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
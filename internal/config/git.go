@@ -0,0 +1,89 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import "strings"
+
+// DefaultTagMessage is used for annotated tags without a tag-message
+const DefaultTagMessage = "Release {{.Tag}}\n\n{{.Changelog}}"
+
+// DefaultCommitMessage is used for release commits without a commit-message
+const DefaultCommitMessage = "chore(neko-release): {{.Version}}"
+
+// GitBackend reads repository state, release commits, tags and pushes always
+// use the git binary
+type GitBackend string
+
+const (
+	// GitBackendExec runs the git binary and parses its output
+	GitBackendExec GitBackend = "exec"
+	// GitBackendGoGit reads the repository with go-git, no git binary needed for checks
+	GitBackendGoGit GitBackend = "go-git"
+)
+
+// IsValid reports whether b is a known backend, empty selects exec
+func (b GitBackend) IsValid() bool {
+	switch b {
+	case "", GitBackendExec, GitBackendGoGit:
+		return true
+	}
+	return false
+}
+
+// GitOptions control how neko creates release commits and tags
+type GitOptions struct {
+	// Backend selects how repository state is read, defaults to exec
+	Backend GitBackend `json:"backend,omitempty"`
+	// AnnotatedTags defaults to true, false creates lightweight tags
+	AnnotatedTags *bool `json:"annotated-tags,omitempty"`
+	// TagMessage is a text/template with .Tag, .Version, .PreviousTag and .Changelog
+	TagMessage string `json:"tag-message,omitempty"`
+	// Sign signs release commits and tags with gpg.format of the git config
+	Sign bool `json:"sign,omitempty"`
+	// SigningKey overrides user.signingkey of the git config
+	SigningKey string `json:"signing-key,omitempty"`
+	// CommitMessage is a text/template with .Version, .Tag and .ReleaseSystem
+	CommitMessage string `json:"commit-message,omitempty"`
+	// Author replaces author and committer of release commits and tags
+	Author *GitAuthor `json:"author,omitempty"`
+	// Files are staged for the release commit instead of all tracked
+	// modifications, globs are expanded and .neko.json is always included
+	Files []string `json:"files,omitempty"`
+}
+
+type GitAuthor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GitOptions returns the git options with defaults applied
+func (c *NekoConfig) GitOptions() GitOptions {
+	if c.Git == nil {
+		return GitOptions{TagMessage: DefaultTagMessage, CommitMessage: DefaultCommitMessage}
+	}
+
+	opts := *c.Git
+	if opts.TagMessage == "" {
+		opts.TagMessage = DefaultTagMessage
+	}
+	if opts.CommitMessage == "" {
+		opts.CommitMessage = DefaultCommitMessage
+	}
+	return opts
+}
+
+// Annotated reports whether tags carry a message, signed tags are always annotated
+func (o GitOptions) Annotated() bool {
+	return o.Sign || o.AnnotatedTags == nil || *o.AnnotatedTags
+}
+
+// CommitPrefix returns the static start of the commit message, e.g.
+// "chore(release):", used to keep release commits out of the changelog
+func (o GitOptions) CommitPrefix() string {
+	prefix, _, _ := strings.Cut(o.CommitMessage, "{{")
+	return strings.TrimSpace(prefix)
+}
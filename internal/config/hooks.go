@@ -0,0 +1,90 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type HookStage string
+
+const (
+	HookPrePreflight HookStage = "pre-preflight"
+	HookPostVersion  HookStage = "post-version"
+	HookPreCommit    HookStage = "pre-commit"
+	HookPostTag      HookStage = "post-tag"
+	HookPostRelease  HookStage = "post-release"
+	HookOnFailure    HookStage = "on-failure"
+)
+
+var HookStages = []HookStage{
+	HookPrePreflight, HookPostVersion, HookPreCommit, HookPostTag, HookPostRelease, HookOnFailure,
+}
+
+// DefaultHookTimeout applies to hooks without an explicit timeout
+const DefaultHookTimeout = 5 * time.Minute
+
+// Hook is a shell command run at a release stage. In .neko.json it is either
+// a plain command string or {"run": "...", "timeout": "30s"}.
+type Hook struct {
+	Run     string `json:"run"`
+	Timeout string `json:"timeout,omitempty"`
+}
+
+func (h *Hook) UnmarshalJSON(data []byte) error {
+	var run string
+	if err := json.Unmarshal(data, &run); err == nil {
+		h.Run = run
+		return nil
+	}
+
+	type plain Hook
+	return json.Unmarshal(data, (*plain)(h))
+}
+
+// Duration returns the timeout of the hook
+func (h Hook) Duration() (time.Duration, error) {
+	if h.Timeout == "" {
+		return DefaultHookTimeout, nil
+	}
+
+	d, err := time.ParseDuration(h.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q for hook %q", h.Timeout, h.Run)
+	}
+	return d, nil
+}
+
+func (s HookStage) IsValid() bool {
+	for _, stage := range HookStages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateHooks checks stage names and timeouts of all configured hooks
+func (c *NekoConfig) ValidateHooks() error {
+	for stage, hooks := range c.Hooks {
+		if !stage.IsValid() {
+			return fmt.Errorf("unknown hook stage %q", stage)
+		}
+
+		for _, hook := range hooks {
+			if hook.Run == "" {
+				return fmt.Errorf("empty hook in stage %q", stage)
+			}
+			if _, err := hook.Duration(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
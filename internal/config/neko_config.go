@@ -7,21 +7,69 @@ package config
 @Since      17.12.2025
 */
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nekoman-hq/neko-cli/internal/ci"
+)
+
 type (
-	ProjectType   string
-	ReleaseSystem string
+	ProjectType     string
+	ReleaseSystem   string
+	Profile         string
+	ReleaseStrategy string
 )
 
+// Project types are descriptive metadata, they do not restrict the release system
 const (
 	ProjectTypeFrontend ProjectType = "frontend"
 	ProjectTypeBackend  ProjectType = "backend"
+	ProjectTypeLibrary  ProjectType = "library"
+	ProjectTypeCLI      ProjectType = "cli"
+	ProjectTypeService  ProjectType = "service"
+	ProjectTypeMobile   ProjectType = "mobile"
+	ProjectTypeInfra    ProjectType = "infra"
 	ProjectTypeOther    ProjectType = "other"
 )
 
+// ProjectTypes lists all project types in the order the wizard offers them
+var ProjectTypes = []ProjectType{
+	ProjectTypeFrontend,
+	ProjectTypeBackend,
+	ProjectTypeLibrary,
+	ProjectTypeCLI,
+	ProjectTypeService,
+	ProjectTypeMobile,
+	ProjectTypeInfra,
+	ProjectTypeOther,
+}
+
 const (
 	ReleaseTypeReleaseIt  ReleaseSystem = "release-it"
 	ReleaseTypeJReleaser  ReleaseSystem = "jreleaser"
 	ReleaseTypeGoReleaser ReleaseSystem = "goreleaser"
+
+	ReleaseTypeSemanticRelease ReleaseSystem = "semantic-release"
+	ReleaseTypeNeko            ReleaseSystem = "neko"
+	ReleaseTypeMaven           ReleaseSystem = "maven"
+	ReleaseTypeGradle          ReleaseSystem = "gradle"
+	ReleaseTypeCargo           ReleaseSystem = "cargo"
+	ReleaseTypePython          ReleaseSystem = "python"
+	ReleaseTypeHelm            ReleaseSystem = "helm"
+)
+
+const (
+	ProfileDefault Profile = "default"
+	ProfileCI      Profile = "ci"
+)
+
+const (
+	// StrategyDirect pushes the release commit straight to the main branch
+	StrategyDirect ReleaseStrategy = "direct"
+	// StrategyPullRequest pushes to release/vX.Y.Z and opens a pull request,
+	// neko release finalize tags the merge commit
+	StrategyPullRequest ReleaseStrategy = "pull-request"
 )
 
 type NekoConfig struct {
@@ -30,24 +78,100 @@ type NekoConfig struct {
 	ProjectType   ProjectType   `json:"project-type"`
 	ReleaseSystem ReleaseSystem `json:"release-system"`
 	Version       string        `json:"version"`
+	Profile       Profile       `json:"profile,omitempty"`
+	// Tools holds release system specific options keyed by release system name
+	Tools map[string]json.RawMessage `json:"tools,omitempty"`
+	// Container builds and pushes images after a successful release
+	Container *ContainerConfig `json:"container,omitempty"`
+	// Plugins maps release system names to plugin executables
+	Plugins map[string]string `json:"plugins,omitempty"`
+	// Hooks are shell commands run at the release stages
+	Hooks map[HookStage][]Hook `json:"hooks,omitempty"`
+	// ReleaseStrategy defaults to direct
+	ReleaseStrategy ReleaseStrategy `json:"release-strategy,omitempty"`
+	// Remote is the git remote releases are pushed to, defaults to origin
+	Remote string `json:"remote,omitempty"`
+	// Git controls annotated and signed release commits and tags
+	Git *GitOptions `json:"git,omitempty"`
+	// Approval adds a checklist to the confirmation before a release
+	Approval *ApprovalConfig `json:"approval,omitempty"`
+	// Freeze blocks releases during the configured windows
+	Freeze *FreezeConfig `json:"freeze,omitempty"`
 	// TagName 	  string 		`json:"tag-name"`   (No implementation yet)
 	// TokenName	  string		`json:"token-name"`	(No implementation yet)
 }
 
 func (p ProjectType) IsValid() bool {
-	switch p {
-	case ProjectTypeFrontend, ProjectTypeBackend, ProjectTypeOther:
+	for _, t := range ProjectTypes {
+		if p == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (r ReleaseSystem) IsValid() bool {
+	switch r {
+	case ReleaseTypeReleaseIt, ReleaseTypeJReleaser, ReleaseTypeGoReleaser,
+		ReleaseTypeSemanticRelease, ReleaseTypeNeko, ReleaseTypeMaven, ReleaseTypeGradle,
+		ReleaseTypeCargo, ReleaseTypePython, ReleaseTypeHelm:
 		return true
 	default:
 		return false
 	}
 }
 
-func (r ReleaseSystem) IsValid() bool {
+// SupportsSigning reports whether neko creates the release tag and can sign
+// it, jreleaser and semantic-release tag on their own without a signature
+func (r ReleaseSystem) SupportsSigning() bool {
 	switch r {
-	case ReleaseTypeReleaseIt, ReleaseTypeJReleaser, ReleaseTypeGoReleaser:
+	case ReleaseTypeJReleaser, ReleaseTypeSemanticRelease:
+		return false
+	default:
+		return true
+	}
+}
+
+func (s ReleaseStrategy) IsValid() bool {
+	switch s {
+	case "", StrategyDirect, StrategyPullRequest:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p Profile) IsValid() bool {
+	switch p {
+	case "", ProfileDefault, ProfileCI:
 		return true
 	default:
 		return false
 	}
 }
+
+// ActiveProfile returns the configured profile. Without an explicit profile the
+// ci profile is selected automatically when a CI environment is detected.
+func (c *NekoConfig) ActiveProfile() Profile {
+	if c.Profile != "" {
+		return c.Profile
+	}
+	if ci.IsCI() {
+		return ProfileCI
+	}
+	return ProfileDefault
+}
+
+// ToolOptions decodes the options of a release system into v. Missing
+// options leave v untouched so callers can preset defaults.
+func (c *NekoConfig) ToolOptions(name string, v interface{}) error {
+	raw, ok := c.Tools[name]
+	if !ok {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("invalid options for %s in .neko.json: %w", name, err)
+	}
+	return nil
+}
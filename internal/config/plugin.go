@@ -0,0 +1,44 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"os/exec"
+	"path/filepath"
+)
+
+// PluginPrefix is the executable prefix of release system plugins on PATH,
+// neko-tool-foo provides the release system foo
+const PluginPrefix = "neko-tool-"
+
+// PluginPath returns the executable of a plugin release system. Plugins
+// declared in .neko.json take precedence over executables on PATH.
+func (c *NekoConfig) PluginPath(name string) (string, bool) {
+	if path, ok := c.Plugins[name]; ok {
+		if !filepath.IsAbs(path) && filepath.Base(path) != path {
+			path, _ = filepath.Abs(path)
+		}
+		return path, true
+	}
+
+	path, err := exec.LookPath(PluginPrefix + name)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// HasReleaseSystem reports whether the configured release system is built in
+// or provided by a plugin
+func (c *NekoConfig) HasReleaseSystem() bool {
+	if c.ReleaseSystem.IsValid() {
+		return true
+	}
+
+	_, ok := c.PluginPath(string(c.ReleaseSystem))
+	return ok
+}
@@ -0,0 +1,60 @@
+package config
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// DefaultRemote is used when neither NEKO_REMOTE nor remote of .neko.json is set
+const DefaultRemote = "origin"
+
+// repositoryConfig holds the settings the git package needs before or
+// without LoadConfig, e.g. in neko init
+type repositoryConfig struct {
+	Remote string `json:"remote"`
+	Git    struct {
+		Backend GitBackend `json:"backend"`
+	} `json:"git"`
+}
+
+// peekRepositoryConfig reads .neko.json without validation, a missing or
+// broken file yields the defaults
+func peekRepositoryConfig() repositoryConfig {
+	var cfg repositoryConfig
+	if data, err := os.ReadFile(configFileName); err == nil {
+		_ = json.Unmarshal(data, &cfg)
+	}
+	return cfg
+}
+
+// GetRemote returns the git remote used for push, fetch, upstream checks and
+// owner/repo resolution. NEKO_REMOTE takes precedence over .neko.json.
+func GetRemote() string {
+	if remote := os.Getenv("NEKO_REMOTE"); remote != "" {
+		return remote
+	}
+
+	if remote := peekRepositoryConfig().Remote; remote != "" {
+		return remote
+	}
+	return DefaultRemote
+}
+
+// GetGitBackend returns the backend reading repository state.
+// NEKO_GIT_BACKEND takes precedence over git.backend of .neko.json.
+func GetGitBackend() GitBackend {
+	if backend := GitBackend(os.Getenv("NEKO_GIT_BACKEND")); backend != "" {
+		return backend
+	}
+
+	if backend := peekRepositoryConfig().Git.Backend; backend != "" {
+		return backend
+	}
+	return GitBackendExec
+}
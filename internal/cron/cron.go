@@ -0,0 +1,187 @@
+// Package cron parses cron-like schedules, e.g. for release freeze windows
+package cron
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule matches the minutes of a five field cron expression:
+// minute hour day-of-month month day-of-week
+type Schedule struct {
+	expr   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domAll and dowAll are set for *, like cron a restricted day-of-month
+	// and day-of-week match when either of them matches
+	domAll bool
+	dowAll bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day-of-month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// dowField accepts 7 for sunday, it is folded into 0
+	dowField = field{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Parse parses a five field cron expression. Fields support *, values,
+// ranges (1-5), lists (1,3), steps (*/15, 8-18/2) and the names of months
+// and weekdays (JAN, FRI).
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q needs 5 fields (minute hour day-of-month month day-of-week), got %d", expr, len(fields))
+	}
+
+	s := &Schedule{
+		expr:   expr,
+		domAll: fields[2] == "*",
+		dowAll: fields[4] == "*",
+	}
+
+	var err error
+	for i, target := range []struct {
+		bits *uint64
+		f    field
+	}{
+		{&s.minute, minuteField},
+		{&s.hour, hourField},
+		{&s.dom, domField},
+		{&s.month, monthField},
+		{&s.dow, dowField},
+	} {
+		if *target.bits, err = target.f.parse(fields[i]); err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+	}
+
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Matches reports whether the minute of t is part of the schedule
+func (s *Schedule) Matches(t time.Time) bool {
+	if s.minute&(1<<t.Minute()) == 0 || s.hour&(1<<t.Hour()) == 0 || s.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+
+	dom := s.dom&(1<<t.Day()) != 0
+	dow := s.dow&(1<<int(t.Weekday())) != 0
+	if s.domAll || s.dowAll {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// End returns the first minute after t that is not part of the schedule.
+// The search stops after limit and returns the zero time.
+func (s *Schedule) End(t time.Time, limit time.Duration) time.Time {
+	t = t.Truncate(time.Minute)
+	for end := t.Add(limit); t.Before(end); t = t.Add(time.Minute) {
+		if !s.Matches(t) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (f field) parse(text string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(text, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func (f field) parsePart(part string) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+		}
+	}
+
+	var from, to int
+	switch {
+	case rangePart == "*":
+		from, to = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		low, high, _ := strings.Cut(rangePart, "-")
+		var err error
+		if from, err = f.value(low); err != nil {
+			return 0, err
+		}
+		if to, err = f.value(high); err != nil {
+			return 0, err
+		}
+		if from > to {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+		}
+	default:
+		value, err := f.value(rangePart)
+		if err != nil {
+			return 0, err
+		}
+		from, to = value, value
+		if hasStep {
+			to = f.max
+		}
+	}
+
+	var bits uint64
+	for v := from; v <= to; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+func (f field) value(text string) (int, error) {
+	if v, ok := f.names[strings.ToLower(text)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(text)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field (%d-%d)", text, f.name, f.min, f.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,149 @@
+package cron
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+// at returns the minute 2026-10-<day> hh:mm in UTC, 2026-10-23 is a friday
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, time.October, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "* 14-23 * * FRI"},
+		{expr: "*/15 8-18/2 1,15 jan-mar mon-fri"},
+		{expr: "0 0 * * 7"},
+		{expr: "* * * *", wantErr: true},
+		{expr: "* * * * * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "* 24 * * *", wantErr: true},
+		{expr: "* * 0 * *", wantErr: true},
+		{expr: "* * * 13 *", wantErr: true},
+		{expr: "* * * * 8", wantErr: true},
+		{expr: "* 18-8 * * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "* * * * FRIDAY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if !tt.wantErr && s.String() != tt.expr {
+				t.Errorf("String() = %q, want %q", s.String(), tt.expr)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		t    time.Time
+		want bool
+	}{
+		// Fridays after 14:00
+		{name: "friday afternoon start", expr: "* 14-23 * * FRI", t: at(23, 14, 0), want: true},
+		{name: "friday night", expr: "* 14-23 * * FRI", t: at(23, 23, 59), want: true},
+		{name: "friday before 14:00", expr: "* 14-23 * * FRI", t: at(23, 13, 59)},
+		{name: "thursday afternoon", expr: "* 14-23 * * FRI", t: at(22, 15, 0)},
+		{name: "saturday midnight", expr: "* 14-23 * * FRI", t: at(24, 0, 0)},
+
+		// 7 is folded into sunday
+		{name: "7 matches sunday", expr: "0 12 * * 7", t: at(25, 12, 0), want: true},
+		{name: "range up to 7 matches sunday", expr: "* * * * 5-7", t: at(25, 8, 30), want: true},
+		{name: "range up to 7 skips monday", expr: "* * * * 5-7", t: at(26, 8, 30)},
+		{name: "0 matches sunday", expr: "0 12 * * 0", t: at(25, 12, 0), want: true},
+		{name: "SUN matches sunday", expr: "0 12 * * SUN", t: at(25, 12, 0), want: true},
+
+		// a restricted day-of-month and day-of-week match when either matches
+		{name: "friday the 13th", expr: "0 0 13 * FRI", t: time.Date(2026, time.November, 13, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "13th on a tuesday", expr: "0 0 13 * FRI", t: at(13, 0, 0), want: true},
+		{name: "friday the 16th", expr: "0 0 13 * FRI", t: at(16, 0, 0), want: true},
+		{name: "neither 13th nor friday", expr: "0 0 13 * FRI", t: at(14, 0, 0)},
+		{name: "day-of-month only", expr: "0 0 13 * *", t: at(16, 0, 0)},
+		{name: "day-of-week only", expr: "0 0 * * FRI", t: at(13, 0, 0)},
+
+		{name: "step minute", expr: "*/15 * * * *", t: at(20, 9, 45), want: true},
+		{name: "between steps", expr: "*/15 * * * *", t: at(20, 9, 46)},
+		{name: "step from value", expr: "5/20 * * * *", t: at(20, 9, 45), want: true},
+		{name: "month name", expr: "* * * oct *", t: at(20, 9, 0), want: true},
+		{name: "other month", expr: "* * * nov,dec *", t: at(20, 9, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expr, err)
+			}
+			if got := s.Matches(tt.t); got != tt.want {
+				t.Errorf("Matches(%s) = %v, want %v", tt.t.Format(time.RFC3339), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnd(t *testing.T) {
+	tests := []struct {
+		name  string
+		expr  string
+		t     time.Time
+		limit time.Duration
+		want  time.Time
+	}{
+		{
+			name:  "end of friday afternoon",
+			expr:  "* 14-23 * * FRI",
+			t:     at(23, 15, 30).Add(20 * time.Second),
+			limit: 24 * time.Hour,
+			want:  at(24, 0, 0),
+		},
+		{
+			name:  "outside the schedule",
+			expr:  "* 14-23 * * FRI",
+			t:     at(22, 15, 30).Add(20 * time.Second),
+			limit: 24 * time.Hour,
+			want:  at(22, 15, 30),
+		},
+		{
+			name:  "weekend over midnight",
+			expr:  "* * * * SAT,7",
+			t:     at(24, 10, 0),
+			limit: 8 * 24 * time.Hour,
+			want:  at(26, 0, 0),
+		},
+		{
+			name:  "beyond the limit",
+			expr:  "* * * * *",
+			t:     at(23, 15, 30),
+			limit: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expr, err)
+			}
+			if got := s.End(tt.t, tt.limit); !got.Equal(tt.want) {
+				t.Errorf("End() = %s, want %s", got.Format(time.RFC3339), tt.want.Format(time.RFC3339))
+			}
+		})
+	}
+}
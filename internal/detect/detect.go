@@ -0,0 +1,244 @@
+// Package detect inspects the working tree to suggest project type, release system and version
+package detect
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+type Ecosystem string
+
+const (
+	Node   Ecosystem = "node"
+	Java   Ecosystem = "java"
+	Go     Ecosystem = "go"
+	Rust   Ecosystem = "rust"
+	Python Ecosystem = "python"
+	Helm   Ecosystem = "helm"
+)
+
+// Result holds everything the detector found, Reasons explains each finding
+type Result struct {
+	Ecosystems    []Ecosystem
+	ProjectType   config.ProjectType
+	ReleaseSystem config.ReleaseSystem
+	Version       string
+	Reasons       []string
+
+	configured config.ReleaseSystem
+	buildFits  []config.ReleaseSystem
+}
+
+type manifest struct {
+	file        string
+	ecosystem   Ecosystem
+	projectType config.ProjectType
+	// systems are release systems tied to this build file, e.g. maven for pom.xml
+	systems []config.ReleaseSystem
+	version func(path string) string
+}
+
+// manifests are checked in order, the first match decides the project type
+var manifests = []manifest{
+	{file: "package.json", ecosystem: Node, projectType: config.ProjectTypeFrontend, version: packageJSONVersion},
+	{file: "pom.xml", ecosystem: Java, projectType: config.ProjectTypeBackend, systems: []config.ReleaseSystem{config.ReleaseTypeMaven}, version: pomVersion},
+	{file: "build.gradle", ecosystem: Java, projectType: config.ProjectTypeBackend, systems: []config.ReleaseSystem{config.ReleaseTypeGradle}, version: gradleVersion},
+	{file: "build.gradle.kts", ecosystem: Java, projectType: config.ProjectTypeBackend, systems: []config.ReleaseSystem{config.ReleaseTypeGradle}, version: gradleVersion},
+	{file: "go.mod", ecosystem: Go, projectType: config.ProjectTypeCLI},
+	{file: "Cargo.toml", ecosystem: Rust, projectType: config.ProjectTypeOther, version: cargoVersion},
+	{file: "pyproject.toml", ecosystem: Python, projectType: config.ProjectTypeLibrary, version: pyprojectVersion},
+	{file: "setup.py", ecosystem: Python, projectType: config.ProjectTypeLibrary},
+	{file: "Chart.yaml", ecosystem: Helm, projectType: config.ProjectTypeInfra, version: chartVersion},
+}
+
+// fits lists the release systems suiting each ecosystem, best fit first
+var fits = map[Ecosystem][]config.ReleaseSystem{
+	Node:   {config.ReleaseTypeReleaseIt, config.ReleaseTypeSemanticRelease},
+	Java:   {config.ReleaseTypeJReleaser},
+	Go:     {config.ReleaseTypeGoReleaser},
+	Rust:   {config.ReleaseTypeCargo},
+	Python: {config.ReleaseTypePython},
+	Helm:   {config.ReleaseTypeHelm},
+}
+
+type toolConfig struct {
+	file    string
+	system  config.ReleaseSystem
+	version func(path string) string
+}
+
+// toolConfigs are existing release system configurations, they take
+// precedence over the release system suggested by the manifest
+var toolConfigs = []toolConfig{
+	{file: ".goreleaser.yaml", system: config.ReleaseTypeGoReleaser},
+	{file: ".goreleaser.yml", system: config.ReleaseTypeGoReleaser},
+	{file: "jreleaser.yml", system: config.ReleaseTypeJReleaser, version: jreleaserVersion},
+	{file: ".release-it.json", system: config.ReleaseTypeReleaseIt},
+	{file: ".releaserc.json", system: config.ReleaseTypeSemanticRelease},
+	{file: ".releaserc", system: config.ReleaseTypeSemanticRelease},
+}
+
+// Run inspects dir and returns the detected values
+func Run(dir string) *Result {
+	log.V(log.Init, fmt.Sprintf("Detecting project setup in %s", log.ColorText(log.ColorGreen, dir)))
+
+	r := &Result{}
+	var versions []candidate
+
+	for _, m := range manifests {
+		path := filepath.Join(dir, m.file)
+		if !exists(path) {
+			continue
+		}
+
+		if !r.has(m.ecosystem) {
+			r.Ecosystems = append(r.Ecosystems, m.ecosystem)
+		}
+		r.buildFits = append(r.buildFits, m.systems...)
+
+		if r.ProjectType == "" {
+			r.ProjectType = m.projectType
+			r.reason("%s found, %s project", m.file, m.ecosystem)
+
+			if systems := fits[m.ecosystem]; len(systems) > 0 {
+				r.ReleaseSystem = systems[0]
+			}
+		}
+
+		if m.version != nil {
+			if v := m.version(path); v != "" {
+				versions = append(versions, candidate{version: v, source: m.file})
+			}
+		}
+	}
+
+	for _, t := range toolConfigs {
+		path := filepath.Join(dir, t.file)
+		if !exists(path) {
+			continue
+		}
+
+		r.ReleaseSystem = t.system
+		r.configured = t.system
+		r.reason("%s found, %s is already configured", t.file, t.system)
+
+		if t.version != nil {
+			if v := t.version(path); v != "" {
+				versions = append(versions, candidate{version: v, source: t.file})
+			}
+		}
+		break
+	}
+
+	if tag := highestTag(); tag != "" {
+		versions = append(versions, candidate{version: tag, source: "git tags"})
+	}
+
+	if best := highest(versions); best != nil {
+		r.Version = best.version
+		r.reason("version %s taken from %s", best.version, best.source)
+	}
+
+	return r
+}
+
+// Fit scores how well a release system suits the detected project. An
+// existing configuration scores highest, followed by release systems tied to
+// a build file and ecosystem matches.
+func (r *Result) Fit(system string) int {
+	if system == string(r.configured) {
+		return 100
+	}
+
+	score := 0
+	for _, s := range r.buildFits {
+		if string(s) == system {
+			score = 60
+		}
+	}
+
+	for _, e := range r.Ecosystems {
+		for i, s := range fits[e] {
+			if string(s) == system {
+				score = max(score, 50-i)
+			}
+		}
+	}
+	return score
+}
+
+// has reports whether the ecosystem was detected
+func (r *Result) has(e Ecosystem) bool {
+	for _, found := range r.Ecosystems {
+		if found == e {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Result) reason(format string, args ...interface{}) {
+	r.Reasons = append(r.Reasons, fmt.Sprintf(format, args...))
+}
+
+type candidate struct {
+	version string
+	source  string
+}
+
+// highest returns the candidate with the highest semantic version
+func highest(candidates []candidate) *candidate {
+	var best *candidate
+	var bestVer *semver.Version
+
+	for i := range candidates {
+		v, err := semver.NewVersion(candidates[i].version)
+		if err != nil {
+			log.V(log.Init, fmt.Sprintf("Ignoring invalid version %s from %s",
+				candidates[i].version, candidates[i].source))
+			continue
+		}
+
+		if bestVer == nil || v.GreaterThan(bestVer) {
+			best = &candidate{version: v.String(), source: candidates[i].source}
+			bestVer = v
+		}
+	}
+	return best
+}
+
+// highestTag returns the highest semantic version tag of the repository
+func highestTag() string {
+	var best *semver.Version
+	for _, tag := range git.GetTags() {
+		v, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if best == nil || v.GreaterThan(best) {
+			best = v
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+	return best.String()
+}
+
+func exists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
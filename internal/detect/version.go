@@ -0,0 +1,159 @@
+package detect
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	pomParentRegex    = regexp.MustCompile(`(?s)<parent>.*?</parent>`)
+	pomVersionRegex   = regexp.MustCompile(`<version>\s*([^<\s]+)\s*</version>`)
+	gradleVersionLine = regexp.MustCompile(`^\s*version\s*=?\s*["']([^"']+)["']`)
+	tomlVersionLine   = regexp.MustCompile(`^\s*version\s*=\s*"([^"]+)"`)
+)
+
+func packageJSONVersion(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	var pkg struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return ""
+	}
+	return pkg.Version
+}
+
+// pomVersion returns the project version, ignoring the version of the parent pom
+func pomVersion(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	content := pomParentRegex.ReplaceAllString(string(data), "")
+	if m := pomVersionRegex.FindStringSubmatch(content); len(m) == 2 {
+		return strings.TrimSuffix(m[1], "-SNAPSHOT")
+	}
+	return ""
+}
+
+// gradleVersion prefers gradle.properties and falls back to the build script
+func gradleVersion(path string) string {
+	props := filepath.Join(filepath.Dir(path), "gradle.properties")
+	if v := propertyValue(props, "version"); v != "" {
+		return strings.TrimSuffix(v, "-SNAPSHOT")
+	}
+
+	return strings.TrimSuffix(firstMatch(path, gradleVersionLine, ""), "-SNAPSHOT")
+}
+
+// cargoVersion returns the version of the [package] or [workspace.package] table
+func cargoVersion(path string) string {
+	if v := firstMatch(path, tomlVersionLine, "[package]"); v != "" {
+		return v
+	}
+	return firstMatch(path, tomlVersionLine, "[workspace.package]")
+}
+
+// pyprojectVersion returns the PEP 621 version and falls back to Poetry
+func pyprojectVersion(path string) string {
+	if v := firstMatch(path, tomlVersionLine, "[project]"); v != "" {
+		return v
+	}
+	return firstMatch(path, tomlVersionLine, "[tool.poetry]")
+}
+
+// chartVersion returns the top-level version of a Chart.yaml
+func chartVersion(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	var chart struct {
+		Version string `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(data, &chart); err != nil {
+		return ""
+	}
+	return chart.Version
+}
+
+func jreleaserVersion(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	var cfg struct {
+		Project struct {
+			Version string `yaml:"version"`
+		} `yaml:"project"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return ""
+	}
+	return cfg.Project.Version
+}
+
+func propertyValue(path, key string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), "=")
+		if ok && strings.TrimSpace(name) == key {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// firstMatch returns the first submatch of re. With a section only lines
+// inside that TOML table are considered.
+func firstMatch(path string, re *regexp.Regexp, section string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+
+	current := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			current = line
+			continue
+		}
+
+		if section != "" && current != section {
+			continue
+		}
+
+		if m := re.FindStringSubmatch(line); len(m) == 2 {
+			return m[1]
+		}
+	}
+	return ""
+}
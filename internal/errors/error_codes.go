@@ -16,11 +16,18 @@ const (
 	ErrDetachedHead     = "NEKO_1006"
 	ErrNoUpstream       = "NEKO_1007"
 	ErrBranchBehind     = "NEKO_1008"
+	ErrShallowClone     = "NEKO_1009"
+	ErrSigningKey       = "NEKO_1010"
+	ErrBranchAhead      = "NEKO_1011"
+	ErrBranchDiverged   = "NEKO_1012"
+	ErrReleaseFrozen    = "NEKO_1013"
 
 	ErrAPIRequest  = "NEKO_2000"
 	ErrAPIResponse = "NEKO_2001"
 	ErrNoReleases  = "NEKO_2002"
 	ErrFileAccess  = "NEKO_2003"
+	ErrTagNotFound = "NEKO_2004"
+	ErrPullRequest = "NEKO_2005"
 
 	ErrConfigExists     = "NEKO_3000"
 	ErrConfigNotExists  = "NEKO_3001"
@@ -30,6 +37,7 @@ const (
 	ErrConfigWrite      = "NEKO_3005"
 	ErrConfigRead       = "NEKO_3006"
 	ErrVersionViolation = "NEKO_3007"
+	ErrTagCollision     = "NEKO_3008"
 
 	ErrInvalidReleaseType   = "NEKO_4000"
 	ErrInvalidReleaseSystem = "NEKO_4001"
@@ -41,4 +49,18 @@ const (
 	ErrJReleaserExecution   = "NEKO_4007"
 	ErrDependencyMissing    = "NEKO_4008"
 	ErrReleaseSystemInit    = "NEKO_4009"
+
+	ErrSemanticReleaseExecution = "NEKO_4010"
+	ErrMavenExecution           = "NEKO_4011"
+	ErrGradleWrapperMissing     = "NEKO_4012"
+	ErrGradleExecution          = "NEKO_4013"
+	ErrCargoExecution           = "NEKO_4014"
+	ErrPythonExecution          = "NEKO_4015"
+	ErrHelmExecution            = "NEKO_4016"
+	ErrContainerExecution       = "NEKO_4017"
+	ErrPluginExecution          = "NEKO_4018"
+	ErrHookFailed               = "NEKO_4019"
+	ErrTagSignature             = "NEKO_4020"
+	ErrPullRequestNotMerged     = "NEKO_4021"
+	ErrReleaseNotApproved       = "NEKO_4022"
 )
@@ -29,6 +29,14 @@ type CLIError struct {
 	Code    string
 }
 
+var onExit func(err CLIError)
+
+// OnExit registers fn to run before the cli exits on an error, e.g. the
+// on-failure hooks of a release. Only the latest registration is kept.
+func OnExit(fn func(err CLIError)) {
+	onExit = fn
+}
+
 func PrintError(err CLIError) {
 	if err.Message == "" {
 		return
@@ -62,6 +70,11 @@ func PrintError(err CLIError) {
 	fmt.Fprintln(os.Stderr)
 
 	if err.Level == ErrorLevelFatal || err.Level == ErrorLevelError {
+		if fn := onExit; fn != nil {
+			// reset first, errors inside fn must not run it again
+			onExit = nil
+			fn(err)
+		}
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,86 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// Backend reads the state of the repository in the working directory.
+// Release commits, tags, pushes and signatures always go through the git
+// binary so hooks, credential helpers and signing programs keep working.
+type Backend interface {
+	Name() string
+	// IsClean reports whether the working tree has no changes, untracked files included
+	IsClean() (bool, error)
+	// CurrentBranch returns the checked out branch, or HEAD when detached
+	CurrentBranch() (string, error)
+	IsShallow() (bool, error)
+	Remotes() ([]string, error)
+	RemoteURL(remote string) (string, error)
+	// HasRef reports whether a full ref like refs/tags/v1.0.0 exists
+	HasRef(ref string) bool
+	// Count returns the number of commits reachable from to but not from from,
+	// an empty from counts the complete history of to
+	Count(from, to string) (int, error)
+	// AheadBehind returns the commits only reachable from local and only
+	// reachable from upstream
+	AheadBehind(local, upstream string) (ahead, behind int, err error)
+	// Commits returns the non-merge commits between from and to, newest first
+	Commits(from, to string) ([]Commit, error)
+	Tags() ([]string, error)
+	// LatestTag returns the nearest tag reachable from HEAD matching one of
+	// the glob patterns, or an empty string if there is none
+	LatestTag(patterns ...string) (string, error)
+	// Resolve returns the full hash of the commit rev points at, tags are peeled
+	Resolve(rev string) (string, error)
+	// IsAncestor reports whether commit is reachable from HEAD
+	IsAncestor(commit string) bool
+}
+
+var active Backend
+
+// UseBackend replaces the backend, e.g. to point it at a fixture repository
+func UseBackend(b Backend) {
+	active = b
+}
+
+// backend returns the configured backend, it is opened on first use
+func backend() Backend {
+	if active != nil {
+		return active
+	}
+
+	switch kind := config.GetGitBackend(); kind {
+	case config.GitBackendGoGit:
+		b, err := OpenGoGit(".")
+		if err != nil {
+			errors.Fatal(
+				"Not a Git Repository",
+				fmt.Sprintf("go-git could not open the repository: %s", err.Error()),
+				errors.ErrNoGitRepo,
+			)
+		}
+		active = b
+	case config.GitBackendExec:
+		active = &ExecBackend{}
+	default:
+		errors.Fatal(
+			"Invalid git backend",
+			fmt.Sprintf("Unknown git backend %q (valid options: exec, go-git)", kind),
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	log.V(log.Config, fmt.Sprintf("Using git backend %s", log.ColorText(log.ColorGreen, active.Name())))
+	return active
+}
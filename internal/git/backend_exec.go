@@ -0,0 +1,214 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// ExecBackend runs the git binary, Dir defaults to the working directory
+type ExecBackend struct {
+	Dir string
+}
+
+func (b *ExecBackend) Name() string {
+	return string(config.GitBackendExec)
+}
+
+func (b *ExecBackend) command(args ...string) *exec.Cmd {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = b.Dir
+	return cmd
+}
+
+// output runs git and returns its trimmed stdout, stderr becomes the error
+func (b *ExecBackend) output(args ...string) (string, error) {
+	output, err := b.command(args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %s", strings.Join(args, " "), strings.TrimSpace(string(output)))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+func (b *ExecBackend) IsClean() (bool, error) {
+	log.V(log.Preflight, fmt.Sprintf("%s (Check branch state)",
+		log.ColorText(log.ColorGreen, "git status --porcelain"),
+	))
+
+	output, err := b.output("status", "--porcelain")
+	if err != nil {
+		return false, err
+	}
+	return output == "", nil
+}
+
+func (b *ExecBackend) CurrentBranch() (string, error) {
+	log.V(log.Preflight, fmt.Sprintf("%s (Determine current branch)",
+		log.ColorText(log.ColorGreen, "git rev-parse --abbrev-ref HEAD"),
+	))
+
+	return b.output("rev-parse", "--abbrev-ref", "HEAD")
+}
+
+func (b *ExecBackend) IsShallow() (bool, error) {
+	log.V(log.Preflight, fmt.Sprintf("%s (Check for shallow clone)",
+		log.ColorText(log.ColorGreen, "git rev-parse --is-shallow-repository"),
+	))
+
+	output, err := b.output("rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return false, err
+	}
+	return output == "true", nil
+}
+
+func (b *ExecBackend) Remotes() ([]string, error) {
+	log.V(log.Config, fmt.Sprintf("%s (Checking Repository Remote)",
+		log.ColorText(log.ColorGreen, "git remote"),
+	))
+
+	output, err := b.output("remote")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(output), nil
+}
+
+func (b *ExecBackend) RemoteURL(remote string) (string, error) {
+	log.V(log.Config, fmt.Sprintf("%s (Resolving repository)",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git remote get-url %s", remote)),
+	))
+
+	return b.output("remote", "get-url", remote)
+}
+
+func (b *ExecBackend) HasRef(ref string) bool {
+	return b.command("rev-parse", "-q", "--verify", ref).Run() == nil
+}
+
+func (b *ExecBackend) Count(from, to string) (int, error) {
+	rev := to
+	if from != "" {
+		rev = fmt.Sprintf("%s..%s", from, to)
+	}
+
+	log.V(log.History, fmt.Sprintf("Counting commits of %s: %s",
+		rev, log.ColorText(log.ColorGreen, fmt.Sprintf("git rev-list --count %s", rev))))
+
+	output, err := b.output("rev-list", "--count", rev)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := strconv.Atoi(output)
+	if err != nil {
+		return 0, fmt.Errorf("invalid count value: %s", output)
+	}
+	return count, nil
+}
+
+func (b *ExecBackend) AheadBehind(local, upstream string) (int, int, error) {
+	rev := fmt.Sprintf("%s...%s", local, upstream)
+
+	log.V(log.Preflight, fmt.Sprintf("Comparing %s with %s: %s", local, upstream,
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git rev-list --left-right --count %s", rev))))
+
+	output, err := b.output("rev-list", "--left-right", "--count", rev)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	counts := strings.Fields(output)
+	if len(counts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %s", output)
+	}
+
+	ahead, err := strconv.Atoi(counts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid ahead count: %s", counts[0])
+	}
+	behind, err := strconv.Atoi(counts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid behind count: %s", counts[1])
+	}
+	return ahead, behind, nil
+}
+
+func (b *ExecBackend) Commits(from, to string) ([]Commit, error) {
+	rev := to
+	if from != "" {
+		rev = fmt.Sprintf("%s..%s", from, to)
+	}
+
+	log.V(log.Release, fmt.Sprintf("Collecting commits: %s",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git log %s", rev))))
+
+	output, err := b.output("log", "--no-merges", "--pretty=format:%h%x1f%s%x1f%an", rev)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.Split(line, "\x1f")
+		if len(parts) != 3 {
+			continue
+		}
+		commits = append(commits, Commit{Hash: parts[0], Subject: parts[1], Author: parts[2]})
+	}
+	return commits, nil
+}
+
+func (b *ExecBackend) Tags() ([]string, error) {
+	log.V(log.History, "Fetching git tags: "+
+		log.ColorText(log.ColorGreen, "git tag"))
+
+	output, err := b.output("tag")
+	if err != nil {
+		return nil, err
+	}
+
+	if output == "" {
+		return []string{}, nil
+	}
+	return strings.Split(output, "\n"), nil
+}
+
+func (b *ExecBackend) LatestTag(patterns ...string) (string, error) {
+	args := []string{"describe", "--tags", "--abbrev=0"}
+	for _, pattern := range patterns {
+		args = append(args, "--match", pattern)
+	}
+
+	log.V(log.Release, fmt.Sprintf("%s (Extract last tag)",
+		log.ColorText(log.ColorGreen, "git "+strings.Join(args, " "))))
+
+	output, err := b.command(args...).Output()
+	if err != nil {
+		// describe fails when no tag matches, which is not an error here
+		if _, ok := err.(*exec.ExitError); ok {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+func (b *ExecBackend) Resolve(rev string) (string, error) {
+	return b.output("rev-parse", "--verify", "-q", rev+"^{commit}")
+}
+
+func (b *ExecBackend) IsAncestor(commit string) bool {
+	return b.command("merge-base", "--is-ancestor", commit, "HEAD").Run() == nil
+}
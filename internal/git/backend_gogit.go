@@ -0,0 +1,430 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"container/heap"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// GoGitBackend reads the repository with go-git, no git binary is needed
+type GoGitBackend struct {
+	repo *gogit.Repository
+}
+
+// OpenGoGit opens the repository containing dir
+func OpenGoGit(dir string) (*GoGitBackend, error) {
+	repo, err := gogit.PlainOpenWithOptions(dir, &gogit.PlainOpenOptions{
+		DetectDotGit:          true,
+		EnableDotGitCommonDir: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &GoGitBackend{repo: repo}, nil
+}
+
+func (b *GoGitBackend) Name() string {
+	return string(config.GitBackendGoGit)
+}
+
+func (b *GoGitBackend) IsClean() (bool, error) {
+	log.V(log.Preflight, "Reading worktree status (go-git)")
+
+	wt, err := b.repo.Worktree()
+	if err != nil {
+		return false, err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return false, err
+	}
+	return status.IsClean(), nil
+}
+
+func (b *GoGitBackend) CurrentBranch() (string, error) {
+	head, err := b.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("reading HEAD failed: %w", err)
+	}
+
+	if !head.Name().IsBranch() {
+		return "HEAD", nil
+	}
+	return head.Name().Short(), nil
+}
+
+func (b *GoGitBackend) IsShallow() (bool, error) {
+	shallow, err := b.repo.Storer.Shallow()
+	if err != nil {
+		return false, err
+	}
+	return len(shallow) > 0, nil
+}
+
+func (b *GoGitBackend) Remotes() ([]string, error) {
+	remotes, err := b.repo.Remotes()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(remotes))
+	for _, remote := range remotes {
+		names = append(names, remote.Config().Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (b *GoGitBackend) RemoteURL(name string) (string, error) {
+	remote, err := b.repo.Remote(name)
+	if err != nil {
+		return "", fmt.Errorf("remote %s: %w", name, err)
+	}
+
+	urls := remote.Config().URLs
+	if len(urls) == 0 {
+		return "", fmt.Errorf("remote %s has no url", name)
+	}
+	return urls[0], nil
+}
+
+func (b *GoGitBackend) HasRef(ref string) bool {
+	_, err := b.repo.Reference(plumbing.ReferenceName(ref), true)
+	return err == nil
+}
+
+func (b *GoGitBackend) Count(from, to string) (int, error) {
+	count := 0
+	err := b.walk(from, to, func(*object.Commit) error {
+		count++
+		return nil
+	})
+	return count, err
+}
+
+func (b *GoGitBackend) AheadBehind(local, upstream string) (int, int, error) {
+	ahead, err := b.Count(upstream, local)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	behind, err := b.Count(local, upstream)
+	if err != nil {
+		return 0, 0, err
+	}
+	return ahead, behind, nil
+}
+
+func (b *GoGitBackend) Commits(from, to string) ([]Commit, error) {
+	log.V(log.Release, fmt.Sprintf("Collecting commits between %s and %s (go-git)", from, to))
+
+	var commits []Commit
+	err := b.walk(from, to, func(c *object.Commit) error {
+		if c.NumParents() > 1 {
+			return nil
+		}
+
+		subject, _, _ := strings.Cut(strings.TrimSpace(c.Message), "\n")
+		commits = append(commits, Commit{
+			Hash:    c.Hash.String()[:7],
+			Subject: strings.TrimSpace(subject),
+			Author:  c.Author.Name,
+		})
+		return nil
+	})
+	return commits, err
+}
+
+func (b *GoGitBackend) Tags() ([]string, error) {
+	refs, err := b.repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		tags = append(tags, ref.Name().Short())
+		return nil
+	})
+	sort.Strings(tags)
+	return tags, err
+}
+
+// describeCandidates bounds the tags LatestTag compares, like the default
+// of git describe --candidates
+const describeCandidates = 10
+
+// LatestTag returns the matching tag with the fewest commits between it and
+// HEAD like git describe. The candidates are the first tagged commits of the
+// history of HEAD newest first.
+func (b *GoGitBackend) LatestTag(patterns ...string) (string, error) {
+	tagged, err := b.taggedCommits(patterns)
+	if err != nil {
+		return "", err
+	}
+	if len(tagged) == 0 {
+		return "", nil
+	}
+
+	head, err := b.resolve("HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	iter, err := b.repo.Log(&gogit.LogOptions{From: head, Order: gogit.LogOrderCommitterTime})
+	if err != nil {
+		return "", err
+	}
+	defer iter.Close()
+
+	var candidates []plumbing.Hash
+	err = iter.ForEach(func(c *object.Commit) error {
+		if _, ok := tagged[c.Hash]; !ok {
+			return nil
+		}
+
+		candidates = append(candidates, c.Hash)
+		// a tag on HEAD itself cannot be beaten
+		if c.Hash == head || len(candidates) == describeCandidates {
+			return storer.ErrStop
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	latest, nearest := "", -1
+	for _, hash := range candidates {
+		depth, err := b.Count(hash.String(), head.String())
+		if err != nil {
+			return "", err
+		}
+		if nearest < 0 || depth < nearest {
+			tags := tagged[hash]
+			latest, nearest = tags[len(tags)-1], depth
+		}
+	}
+	return latest, nil
+}
+
+func (b *GoGitBackend) Resolve(rev string) (string, error) {
+	c, err := b.commit(rev)
+	if err != nil {
+		return "", err
+	}
+	return c.Hash.String(), nil
+}
+
+func (b *GoGitBackend) IsAncestor(commit string) bool {
+	ancestor, err := b.commit(commit)
+	if err != nil {
+		return false
+	}
+
+	head, err := b.commit("HEAD")
+	if err != nil {
+		return false
+	}
+
+	ok, err := ancestor.IsAncestor(head)
+	return err == nil && ok
+}
+
+// taggedCommits maps commits to their tags matching one of patterns,
+// annotated tags are peeled to the commit they point at
+func (b *GoGitBackend) taggedCommits(patterns []string) (map[plumbing.Hash][]string, error) {
+	refs, err := b.repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	tagged := map[plumbing.Hash][]string{}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if !matchesAny(name, patterns) {
+			return nil
+		}
+
+		hash := ref.Hash()
+		if tag, err := b.repo.TagObject(hash); err == nil {
+			c, err := tag.Commit()
+			if err != nil {
+				return nil
+			}
+			hash = c.Hash
+		}
+
+		tagged[hash] = append(tagged[hash], name)
+		return nil
+	})
+
+	for _, tags := range tagged {
+		sort.Strings(tags)
+	}
+	return tagged, err
+}
+
+// walkSlop is the number of commits walked after only excluded commits are
+// queued, like git rev-list it tolerates some clock skew between commits
+const walkSlop = 5
+
+// walk calls fn for every commit reachable from to but not from from, newest
+// first. Both sides are walked together by commit time and the walk stops at
+// their merge base instead of reading the complete history of from.
+func (b *GoGitBackend) walk(from, to string, fn func(*object.Commit) error) error {
+	tip, err := b.commit(to)
+	if err != nil {
+		return err
+	}
+
+	queue := &commitQueue{}
+	seen := map[plumbing.Hash]bool{}
+	push := func(c *object.Commit) {
+		if !seen[c.Hash] {
+			seen[c.Hash] = true
+			heap.Push(queue, c)
+		}
+	}
+
+	// excluded commits are reachable from from, exclude marks the parents
+	// of commits that were already walked as well
+	excluded := map[plumbing.Hash]bool{}
+	parents := map[plumbing.Hash][]plumbing.Hash{}
+	var exclude func(hash plumbing.Hash)
+	exclude = func(hash plumbing.Hash) {
+		if excluded[hash] {
+			return
+		}
+		excluded[hash] = true
+		for _, parent := range parents[hash] {
+			exclude(parent)
+		}
+	}
+
+	if from != "" {
+		base, err := b.commit(from)
+		if err != nil {
+			return err
+		}
+		exclude(base.Hash)
+		push(base)
+	}
+	push(tip)
+
+	var walked []*object.Commit
+	for slop := walkSlop; queue.Len() > 0; {
+		if queue.onlyExcluded(excluded) {
+			if slop--; slop == 0 {
+				break
+			}
+		} else {
+			slop = walkSlop
+		}
+
+		c := heap.Pop(queue).(*object.Commit)
+		parents[c.Hash] = c.ParentHashes
+		for _, hash := range c.ParentHashes {
+			if excluded[c.Hash] {
+				exclude(hash)
+			}
+			if seen[hash] {
+				continue
+			}
+
+			parent, err := b.repo.CommitObject(hash)
+			if err == plumbing.ErrObjectNotFound {
+				// the boundary of a shallow clone
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			push(parent)
+		}
+		walked = append(walked, c)
+	}
+
+	for _, c := range walked {
+		if excluded[c.Hash] {
+			continue
+		}
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitQueue is a heap of commits, newest commit time first
+type commitQueue []*object.Commit
+
+func (q commitQueue) Len() int           { return len(q) }
+func (q commitQueue) Less(i, j int) bool { return q[i].Committer.When.After(q[j].Committer.When) }
+func (q commitQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *commitQueue) Push(x interface{}) {
+	*q = append(*q, x.(*object.Commit))
+}
+
+func (q *commitQueue) Pop() interface{} {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
+
+func (q commitQueue) onlyExcluded(excluded map[plumbing.Hash]bool) bool {
+	for _, c := range q {
+		if !excluded[c.Hash] {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *GoGitBackend) resolve(rev string) (plumbing.Hash, error) {
+	hash, err := b.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("unknown revision %s: %w", rev, err)
+	}
+	return *hash, nil
+}
+
+func (b *GoGitBackend) commit(rev string) (*object.Commit, error) {
+	hash, err := b.resolve(rev)
+	if err != nil {
+		return nil, err
+	}
+	return b.repo.CommitObject(hash)
+}
+
+func matchesAny(name string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
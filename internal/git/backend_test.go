@@ -0,0 +1,301 @@
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fixtureEpoch is the commit time of the first fixture commit, every
+// further commit is one minute newer
+const fixtureEpoch = 1767225600
+
+// fixture is a repository in a temporary directory built with the git binary
+type fixture struct {
+	t       *testing.T
+	dir     string
+	commits int
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	f := &fixture{t: t, dir: t.TempDir()}
+	f.git("init", "-q", "-b", "main")
+	return f
+}
+
+// git runs git in the fixture with a fixed identity and commit time, the
+// global and system config of the machine are ignored
+func (f *fixture) git(args ...string) string {
+	f.t.Helper()
+
+	date := fmt.Sprintf("%d +0000", fixtureEpoch+60*f.commits)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = f.dir
+	cmd.Env = append(os.Environ(),
+		"GIT_CONFIG_GLOBAL="+os.DevNull,
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_AUTHOR_NAME=Neko", "GIT_AUTHOR_EMAIL=neko@example.com", "GIT_AUTHOR_DATE="+date,
+		"GIT_COMMITTER_NAME=Neko", "GIT_COMMITTER_EMAIL=neko@example.com", "GIT_COMMITTER_DATE="+date,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		f.t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// commit commits a change of file with subject
+func (f *fixture) commit(file, subject string) {
+	f.t.Helper()
+
+	f.commits++
+	if err := os.WriteFile(filepath.Join(f.dir, file), []byte(subject+"\n"), 0644); err != nil {
+		f.t.Fatal(err)
+	}
+	f.git("add", file)
+	f.git("commit", "-q", "-m", subject)
+}
+
+// backends opens the fixture with every backend
+func (f *fixture) backends() map[string]Backend {
+	f.t.Helper()
+
+	goGit, err := OpenGoGit(f.dir)
+	if err != nil {
+		f.t.Fatalf("OpenGoGit() error = %v", err)
+	}
+	return map[string]Backend{
+		"exec":   &ExecBackend{Dir: f.dir},
+		"go-git": goGit,
+	}
+}
+
+// newHistoryFixture builds this history, side is merged into main and
+// other diverges from v1.1.0:
+//
+//	main   A(v1.0.0, api/v1.0.0) - B - C - D(v1.1.0) ---------- M
+//	side     \- S1(v2.0.0) - S2 - S3 ---------------------------/
+//	other                       D - O
+//
+// S1 is newer than D, so the first tag of HEAD by date is v2.0.0 while
+// v1.1.0 is nearer.
+func newHistoryFixture(t *testing.T) *fixture {
+	f := newFixture(t)
+
+	f.commit("a.txt", "feat: initial")
+	f.git("tag", "-a", "-m", "v1.0.0", "v1.0.0")
+	f.git("tag", "-a", "-m", "api v1.0.0", "api/v1.0.0")
+	f.commit("b.txt", "fix: b")
+	f.commit("c.txt", "feat: c")
+	f.commit("d.txt", "fix: d")
+	f.git("tag", "v1.1.0")
+
+	f.git("checkout", "-q", "-b", "side", "v1.0.0")
+	f.commit("s.txt", "feat: side one")
+	f.git("tag", "-a", "-m", "v2.0.0", "v2.0.0")
+	f.commit("s.txt", "fix: side two")
+	f.commit("s.txt", "fix: side three")
+
+	f.git("checkout", "-q", "main")
+	f.commits++
+	f.git("merge", "-q", "--no-ff", "-m", "Merge branch side", "side")
+
+	f.git("checkout", "-q", "-b", "other", "v1.1.0")
+	f.commit("o.txt", "feat: other")
+	f.git("checkout", "-q", "main")
+	return f
+}
+
+func subjects(commits []Commit) []string {
+	result := []string{}
+	for _, c := range commits {
+		result = append(result, c.Subject)
+	}
+	return result
+}
+
+func TestBackends(t *testing.T) {
+	f := newHistoryFixture(t)
+
+	tests := []struct {
+		name string
+		run  func(b Backend) (interface{}, error)
+		want interface{}
+	}{
+		{
+			name: "clean worktree",
+			run:  func(b Backend) (interface{}, error) { return b.IsClean() },
+			want: true,
+		},
+		{
+			name: "current branch",
+			run:  func(b Backend) (interface{}, error) { return b.CurrentBranch() },
+			want: "main",
+		},
+		{
+			name: "count complete history",
+			run:  func(b Backend) (interface{}, error) { return b.Count("", "main") },
+			want: 8,
+		},
+		{
+			name: "count since annotated tag",
+			run:  func(b Backend) (interface{}, error) { return b.Count("v1.0.0", "main") },
+			want: 7,
+		},
+		{
+			name: "count since merged tag",
+			run:  func(b Backend) (interface{}, error) { return b.Count("v1.1.0", "main") },
+			want: 4,
+		},
+		{
+			name: "count since side tag",
+			run:  func(b Backend) (interface{}, error) { return b.Count("v2.0.0", "main") },
+			want: 6,
+		},
+		{
+			name: "count of ancestor",
+			run:  func(b Backend) (interface{}, error) { return b.Count("main", "v1.1.0") },
+			want: 0,
+		},
+		{
+			name: "ahead and behind diverged branch",
+			run: func(b Backend) (interface{}, error) {
+				ahead, behind, err := b.AheadBehind("main", "other")
+				return [2]int{ahead, behind}, err
+			},
+			want: [2]int{4, 1},
+		},
+		{
+			name: "ahead and behind merged branch",
+			run: func(b Backend) (interface{}, error) {
+				ahead, behind, err := b.AheadBehind("side", "main")
+				return [2]int{ahead, behind}, err
+			},
+			want: [2]int{0, 4},
+		},
+		{
+			name: "commits without merges newest first",
+			run: func(b Backend) (interface{}, error) {
+				commits, err := b.Commits("v1.1.0", "main")
+				return subjects(commits), err
+			},
+			want: []string{"fix: side three", "fix: side two", "feat: side one"},
+		},
+		{
+			name: "commits of diverged branch",
+			run: func(b Backend) (interface{}, error) {
+				commits, err := b.Commits("main", "other")
+				return subjects(commits), err
+			},
+			want: []string{"feat: other"},
+		},
+		{
+			name: "nearest tag wins over newest tag",
+			run:  func(b Backend) (interface{}, error) { return b.LatestTag() },
+			want: "v1.1.0",
+		},
+		{
+			name: "latest tag of match pattern",
+			run:  func(b Backend) (interface{}, error) { return b.LatestTag("v2.*") },
+			want: "v2.0.0",
+		},
+		{
+			name: "latest component tag",
+			run:  func(b Backend) (interface{}, error) { return b.LatestTag("api/*") },
+			want: "api/v1.0.0",
+		},
+		{
+			name: "latest tag of several patterns",
+			run:  func(b Backend) (interface{}, error) { return b.LatestTag("v1.0.*", "v2.*") },
+			want: "v2.0.0",
+		},
+		{
+			name: "no matching tag",
+			run:  func(b Backend) (interface{}, error) { return b.LatestTag("web/*") },
+			want: "",
+		},
+		{
+			name: "resolve annotated tag",
+			run:  func(b Backend) (interface{}, error) { return b.Resolve("v2.0.0") },
+			want: f.git("rev-parse", "v2.0.0^{commit}"),
+		},
+		{
+			name: "resolve lightweight tag",
+			run:  func(b Backend) (interface{}, error) { return b.Resolve("v1.1.0") },
+			want: f.git("rev-parse", "v1.1.0"),
+		},
+	}
+
+	for name, b := range f.backends() {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				got, err := tt.run(b)
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestBackendsWorktree(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(f *fixture)
+		wantClean  bool
+		wantBranch string
+	}{
+		{
+			name:       "untracked file",
+			setup:      func(f *fixture) { _ = os.WriteFile(filepath.Join(f.dir, "new.txt"), nil, 0644) },
+			wantBranch: "main",
+		},
+		{
+			name:       "modified file",
+			setup:      func(f *fixture) { _ = os.WriteFile(filepath.Join(f.dir, "a.txt"), []byte("changed\n"), 0644) },
+			wantBranch: "main",
+		},
+		{
+			name:       "detached head",
+			setup:      func(f *fixture) { f.git("checkout", "-q", "--detach") },
+			wantClean:  true,
+			wantBranch: "HEAD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.commit("a.txt", "feat: initial")
+			tt.setup(f)
+
+			for name, b := range f.backends() {
+				if clean, err := b.IsClean(); err != nil || clean != tt.wantClean {
+					t.Errorf("%s: IsClean() = %v, %v, want %v", name, clean, err, tt.wantClean)
+				}
+				if branch, err := b.CurrentBranch(); err != nil || branch != tt.wantBranch {
+					t.Errorf("%s: CurrentBranch() = %q, %v, want %q", name, branch, err, tt.wantBranch)
+				}
+			}
+		})
+	}
+}
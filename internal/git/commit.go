@@ -0,0 +1,43 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+type Commit struct {
+	Hash    string
+	Subject string
+	Author  string
+}
+
+// CommitsBetween returns the commits reachable from to but not from from,
+// newest first. An empty from returns the complete history of to.
+func CommitsBetween(from, to string) ([]Commit, error) {
+	return backend().Commits(from, to)
+}
+
+// Add stages new files so they become part of the release commit, which
+// itself only picks up changes of tracked files
+func Add(paths ...string) error {
+	args := append([]string{"add", "--"}, paths...)
+
+	log.V(log.Release, fmt.Sprintf("Staging files: %s",
+		log.ColorText(log.ColorGreen, "git "+strings.Join(args, " "))))
+
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git add failed: %s", strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ package github
 */
 
 type Release struct {
+	ID          int64  `json:"id"`
 	Name        string `json:"name"`
 	TagName     string `json:"tag_name"`
 	PublishedAt string `json:"published_at"`
@@ -15,6 +16,43 @@ type Release struct {
 	Author      Author `json:"author"`
 	HTMLURL     string `json:"html_url"`
 	Body        string `json:"body"`
+	UploadURL   string `json:"upload_url"`
+}
+
+type ReleaseRequest struct {
+	TagName    string `json:"tag_name"`
+	Name       string `json:"name"`
+	Body       string `json:"body"`
+	Draft      bool   `json:"draft"`
+	PreRelease bool   `json:"prerelease"`
+}
+
+type PullRequest struct {
+	Number         int     `json:"number"`
+	State          string  `json:"state"`
+	HTMLURL        string  `json:"html_url"`
+	MergedAt       *string `json:"merged_at"`
+	MergeCommitSHA string  `json:"merge_commit_sha"`
+	Head           Ref     `json:"head"`
+	Base           Ref     `json:"base"`
+}
+
+type PullRequestRequest struct {
+	Title string `json:"title"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Body  string `json:"body"`
+}
+
+type Ref struct {
+	Ref string `json:"ref"`
+	Sha string `json:"sha"`
+}
+
+type Asset struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
 }
 
 type Author struct {
@@ -0,0 +1,232 @@
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/nekoman-hq/neko-cli/internal/git/github"
+)
+
+var testRepo = &RepoInfo{Owner: "acme", Repo: "demo"}
+
+// apiResponse is the canned answer of the fake GitHub API
+type apiResponse struct {
+	status int
+	body   string
+}
+
+// fakeAPI points NEKO_API_URL at a server answering with resp. Requests to
+// another method or path than want fail the test, the decoded JSON body of
+// the request is stored in received.
+func fakeAPI(t *testing.T, method, path string, resp apiResponse, received interface{}) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.EscapedPath() != path {
+			t.Errorf("request %s %s, want %s %s", r.Method, r.URL.EscapedPath(), method, path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want bearer token", got)
+		}
+
+		if received != nil {
+			data, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(data, received); err != nil {
+				t.Errorf("request body %q is no JSON: %v", data, err)
+			}
+		}
+
+		w.WriteHeader(resp.status)
+		_, _ = io.WriteString(w, resp.body)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("NEKO_API_URL", server.URL)
+	t.Setenv("GITHUB_TOKEN", "test-token")
+}
+
+// wantAPIError checks that err is an apiError with status and body
+func wantAPIError(t *testing.T, err error, status int, body string) {
+	t.Helper()
+
+	var apiErr *apiError
+	if !stderrors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want API error", err)
+	}
+	if apiErr.status != status || apiErr.body != body {
+		t.Errorf("API error = %d %q, want %d %q", apiErr.status, apiErr.body, status, body)
+	}
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	const duplicate = `{"message":"Validation Failed","errors":[{"resource":"PullRequest","code":"custom","message":"A pull request already exists for acme:release/v1.1.0."}]}`
+
+	req := github.PullRequestRequest{Title: "chore(neko-release): v1.1.0", Head: "release/v1.1.0", Base: "main", Body: "notes"}
+
+	tests := []struct {
+		name    string
+		resp    apiResponse
+		want    *github.PullRequest
+		wantErr int
+	}{
+		{
+			name: "created",
+			resp: apiResponse{http.StatusCreated, `{"number":7,"state":"open","html_url":"https://github.com/acme/demo/pull/7","head":{"ref":"release/v1.1.0"},"base":{"ref":"main"}}`},
+			want: &github.PullRequest{Number: 7, State: "open", HTMLURL: "https://github.com/acme/demo/pull/7",
+				Head: github.Ref{Ref: "release/v1.1.0"}, Base: github.Ref{Ref: "main"}},
+		},
+		{
+			name:    "already exists",
+			resp:    apiResponse{http.StatusUnprocessableEntity, duplicate},
+			wantErr: http.StatusUnprocessableEntity,
+		},
+		{
+			name:    "server error",
+			resp:    apiResponse{http.StatusInternalServerError, `{"message":"Server Error"}`},
+			wantErr: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received github.PullRequestRequest
+			fakeAPI(t, http.MethodPost, "/repos/acme/demo/pulls", tt.resp, &received)
+
+			got, err := CreatePullRequest(testRepo, req)
+			if received != req {
+				t.Errorf("request = %+v, want %+v", received, req)
+			}
+			if tt.wantErr != 0 {
+				wantAPIError(t, err, tt.wantErr, tt.resp.body)
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreatePullRequest() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreatePullRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateRelease(t *testing.T) {
+	const duplicate = `{"message":"Validation Failed","errors":[{"resource":"Release","code":"already_exists","field":"tag_name"}]}`
+
+	req := github.ReleaseRequest{TagName: "v1.1.0", Name: "v1.1.0", Body: "notes", PreRelease: true}
+
+	tests := []struct {
+		name    string
+		resp    apiResponse
+		want    *github.Release
+		wantErr int
+	}{
+		{
+			name: "created",
+			resp: apiResponse{http.StatusCreated, `{"id":42,"name":"v1.1.0","tag_name":"v1.1.0","prerelease":true,"html_url":"https://github.com/acme/demo/releases/tag/v1.1.0","upload_url":"https://uploads.github.com/repos/acme/demo/releases/42/assets{?name,label}"}`},
+			want: &github.Release{ID: 42, Name: "v1.1.0", TagName: "v1.1.0", PreRelease: true,
+				HTMLURL:   "https://github.com/acme/demo/releases/tag/v1.1.0",
+				UploadURL: "https://uploads.github.com/repos/acme/demo/releases/42/assets{?name,label}"},
+		},
+		{
+			name:    "already exists",
+			resp:    apiResponse{http.StatusUnprocessableEntity, duplicate},
+			wantErr: http.StatusUnprocessableEntity,
+		},
+		{
+			name:    "unauthorized",
+			resp:    apiResponse{http.StatusUnauthorized, `{"message":"Bad credentials"}`},
+			wantErr: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received github.ReleaseRequest
+			fakeAPI(t, http.MethodPost, "/repos/acme/demo/releases", tt.resp, &received)
+
+			got, err := CreateRelease(testRepo, req)
+			if received != req {
+				t.Errorf("request = %+v, want %+v", received, req)
+			}
+			if tt.wantErr != 0 {
+				wantAPIError(t, err, tt.wantErr, tt.resp.body)
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateRelease() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateRelease() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseByTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		path    string
+		resp    apiResponse
+		want    *github.Release
+		wantErr int
+	}{
+		{
+			name: "found",
+			tag:  "v1.1.0",
+			path: "/repos/acme/demo/releases/tags/v1.1.0",
+			resp: apiResponse{http.StatusOK, `{"id":42,"tag_name":"v1.1.0","body":"notes"}`},
+			want: &github.Release{ID: 42, TagName: "v1.1.0", Body: "notes"},
+		},
+		{
+			name: "component tag is escaped",
+			tag:  "api/v1.1.0",
+			path: "/repos/acme/demo/releases/tags/api%2Fv1.1.0",
+			resp: apiResponse{http.StatusOK, `{"id":43,"tag_name":"api/v1.1.0"}`},
+			want: &github.Release{ID: 43, TagName: "api/v1.1.0"},
+		},
+		{
+			name: "no release",
+			tag:  "v1.1.0",
+			path: "/repos/acme/demo/releases/tags/v1.1.0",
+			resp: apiResponse{http.StatusNotFound, `{"message":"Not Found"}`},
+		},
+		{
+			name:    "server error",
+			tag:     "v1.1.0",
+			path:    "/repos/acme/demo/releases/tags/v1.1.0",
+			resp:    apiResponse{http.StatusBadGateway, "upstream unavailable"},
+			wantErr: http.StatusBadGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAPI(t, http.MethodGet, tt.path, tt.resp, nil)
+
+			got, err := ReleaseByTag(testRepo, tt.tag)
+			if tt.wantErr != 0 {
+				wantAPIError(t, err, tt.wantErr, tt.resp.body)
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReleaseByTag() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReleaseByTag() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/git/github"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// CreatePullRequest opens a pull request from head into base
+func CreatePullRequest(repoInfo *RepoInfo, req github.PullRequestRequest) (*github.PullRequest, error) {
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/pulls", config.GetAPIURL(), repoInfo.Owner, repoInfo.Repo)
+
+	log.V(log.Release, fmt.Sprintf("Opening pull request %s \uF178 %s: %s",
+		req.Head, req.Base, log.ColorText(log.ColorGreen, "POST "+apiURL)))
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("encode pull request: %w", err)
+	}
+
+	var pr github.PullRequest
+	if err := githubRequest(http.MethodPost, apiURL, "application/json", bytes.NewReader(payload), &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// MergedPullRequest returns the merged pull request of branch, or nil if
+// no pull request of branch has been merged yet
+func MergedPullRequest(repoInfo *RepoInfo, branch string) (*github.PullRequest, error) {
+	query := url.Values{}
+	query.Set("state", "closed")
+	query.Set("head", fmt.Sprintf("%s:%s", repoInfo.Owner, branch))
+
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/pulls?%s", config.GetAPIURL(), repoInfo.Owner, repoInfo.Repo, query.Encode())
+
+	log.V(log.Release, fmt.Sprintf("Looking up merged pull request of %s: %s",
+		branch, log.ColorText(log.ColorGreen, "GET "+apiURL)))
+
+	var prs []github.PullRequest
+	if err := githubRequest(http.MethodGet, apiURL, "", nil, &prs); err != nil {
+		return nil, err
+	}
+
+	for _, pr := range prs {
+		if pr.MergedAt != nil && pr.MergeCommitSHA != "" {
+			return &pr, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateBranch creates and checks out branch at HEAD, local changes are kept
+func CreateBranch(branch string) error {
+	return runGit("checkout", "-b", branch)
+}
+
+// Checkout switches to an existing branch
+func Checkout(branch string) error {
+	return runGit("checkout", branch)
+}
+
+// IsAncestor reports whether commit is reachable from HEAD
+func IsAncestor(commit string) bool {
+	return backend().IsAncestor(commit)
+}
+
+func runGit(args ...string) error {
+	log.V(log.Release, fmt.Sprintf("Running %s", log.ColorText(log.ColorGreen, "git "+strings.Join(args, " "))))
+
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git %s failed: %s", strings.Join(args, " "), strings.TrimSpace(string(output)))
+	}
+	return nil
+}
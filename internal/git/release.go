@@ -0,0 +1,142 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/git/github"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// CreateRelease creates a hosted release for an existing tag
+func CreateRelease(repoInfo *RepoInfo, req github.ReleaseRequest) (*github.Release, error) {
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/releases", config.GetAPIURL(), repoInfo.Owner, repoInfo.Repo)
+
+	log.V(log.Release, fmt.Sprintf("Creating release %s: %s",
+		req.TagName, log.ColorText(log.ColorGreen, "POST "+apiURL)))
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("encode release request: %w", err)
+	}
+
+	var release github.Release
+	if err := githubRequest(http.MethodPost, apiURL, "application/json", bytes.NewReader(payload), &release); err != nil {
+		return nil, err
+	}
+
+	log.V(log.Release, fmt.Sprintf("Created release %s", log.ColorText(log.ColorGreen, release.HTMLURL)))
+	return &release, nil
+}
+
+// UploadReleaseAsset attaches a local file to a hosted release
+func UploadReleaseAsset(release *github.Release, path string) (*github.Asset, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open asset %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	// upload_url is a URI template like https://uploads.github.com/.../assets{?name,label}
+	base, _, _ := strings.Cut(release.UploadURL, "{")
+	uploadURL := fmt.Sprintf("%s?name=%s", base, url.QueryEscape(filepath.Base(path)))
+
+	log.V(log.Release, fmt.Sprintf("Uploading asset %s: %s",
+		filepath.Base(path), log.ColorText(log.ColorGreen, "POST "+uploadURL)))
+
+	var asset github.Asset
+	if err := githubRequest(http.MethodPost, uploadURL, "application/octet-stream", file, &asset); err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// ReleaseByTag returns the hosted release of tag, or nil if there is none
+func ReleaseByTag(repoInfo *RepoInfo, tag string) (*github.Release, error) {
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/releases/tags/%s", config.GetAPIURL(), repoInfo.Owner, repoInfo.Repo, url.PathEscape(tag))
+
+	log.V(log.Release, fmt.Sprintf("Looking up release %s: %s",
+		tag, log.ColorText(log.ColorGreen, "GET "+apiURL)))
+
+	var release github.Release
+	err := githubRequest(http.MethodGet, apiURL, "", nil, &release)
+
+	var apiErr *apiError
+	if stderrors.As(err, &apiErr) && apiErr.status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// apiError is a non-2xx response of the GitHub API
+type apiError struct {
+	status int
+	body   string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("GitHub API returned status %d: %s", e.status, e.body)
+}
+
+// githubRequest sends an authenticated request and decodes the JSON response into out
+func githubRequest(method, apiURL, contentType string, body io.Reader, out interface{}) error {
+	req, err := http.NewRequest(method, apiURL, body)
+	if err != nil {
+		return fmt.Errorf("could not create API request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.GetPAT()))
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	if file, ok := body.(*os.File); ok {
+		if info, err := file.Stat(); err == nil {
+			req.ContentLength = info.Size()
+		}
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %w", method, apiURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read API response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &apiError{status: resp.StatusCode, body: strings.TrimSpace(string(data))}
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("could not parse API response: %w", err)
+	}
+	return nil
+}
@@ -11,8 +11,11 @@ import (
 	"fmt"
 	"os/exec"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/nekoman-hq/neko-cli/internal/config"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
 	"github.com/nekoman-hq/neko-cli/internal/log"
 )
@@ -27,22 +30,47 @@ type Contributor struct {
 	Author  string
 }
 
+// Remote returns the name of the release remote
+func Remote() string {
+	return config.GetRemote()
+}
+
+// PushURL returns the url the release remote pushes to, pushurl and
+// pushInsteadOf of the git config included
+func PushURL() (string, error) {
+	remote := Remote()
+	log.V(log.Release, fmt.Sprintf("%s (Resolving push url)",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git remote get-url --push %s", remote)),
+	))
+
+	output, err := exec.Command("git", "remote", "get-url", "--push", remote).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git remote get-url --push %s failed: %s", remote, strings.TrimSpace(string(output)))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Fetch updates branches and tags of the release remote. A failed fetch is
+// not fatal, the checks afterwards work on the local state.
 func Fetch() {
+	remote := Remote()
 	log.V(log.VersionGuard, fmt.Sprintf("%s (Updating repository information)",
-		log.ColorText(log.ColorGreen, "git fetch"),
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git fetch --tags %s", remote)),
 	))
 
-	exec.Command("git", "fetch")
+	output, err := exec.Command("git", "fetch", "--tags", remote).CombinedOutput()
+	if err != nil {
+		log.V(log.VersionGuard, fmt.Sprintf("Fetching %s failed, using local state: %s",
+			remote, strings.TrimSpace(string(output))))
+	}
 }
 
 // Current checks if a git repository exists and returns owner and repo name
+// of the release remote
 func Current() (*RepoInfo, error) {
-	log.V(log.Config, fmt.Sprintf("%s (Checking Repository Origin)",
-		log.ColorText(log.ColorGreen, "git remote -v"),
-	))
+	remote := Remote()
 
-	cmd := exec.Command("git", "remote", "-v")
-	output, err := cmd.CombinedOutput()
+	remotes, err := backend().Remotes()
 	if err != nil {
 		errors.Fatal(
 			"Not a Git Repository",
@@ -51,19 +79,36 @@ func Current() (*RepoInfo, error) {
 		)
 	}
 
-	outputStr := string(output)
-	if strings.TrimSpace(outputStr) == "" {
+	if len(remotes) == 0 {
+		errors.Fatal(
+			"No Remote Found",
+			fmt.Sprintf("This git repository has no remote configured.\nAdd a remote with: git remote add %s <url>", remote),
+			errors.ErrNoRemote,
+		)
+	}
+
+	if !slices.Contains(remotes, remote) {
 		errors.Fatal(
 			"No Remote Found",
-			"This git repository has no remote configured.\nAdd a remote with: git remote add origin <url>",
+			fmt.Sprintf("Remote %s does not exist, configured remotes: %s.\nSet remote in .neko.json or add it with: git remote add %s <url>",
+				remote, strings.Join(remotes, ", "), remote),
 			errors.ErrNoRemote,
 		)
 	}
-	return parseRemote(outputStr)
+
+	remoteURL, err := backend().RemoteURL(remote)
+	if err != nil {
+		errors.Fatal(
+			"Invalid Remote URL",
+			fmt.Sprintf("Could not read the url of remote %s: %s", remote, err.Error()),
+			errors.ErrInvalidRemote,
+		)
+	}
+	return parseRemote(remoteURL)
 }
 
-// parseRemote extracts owner and repo from git remote output
-func parseRemote(remoteOutput string) (*RepoInfo, error) {
+// parseRemote extracts owner and repo from a remote url
+func parseRemote(remoteURL string) (*RepoInfo, error) {
 	// Regex patterns for both SSH and HTTPS URLs
 	// SSH: git@git.com:owner/repo.git
 	sshPattern := regexp.MustCompile(`git@github\.com:([^/]+)/([^/\s]+?)(?:\.git)?(?:\s|$)`)
@@ -71,7 +116,7 @@ func parseRemote(remoteOutput string) (*RepoInfo, error) {
 	httpsPattern := regexp.MustCompile(`https://github\.com/([^/]+)/([^/\s]+?)(?:\.git)?(?:\s|$)`)
 
 	// Try SSH pattern first
-	if matches := sshPattern.FindStringSubmatch(remoteOutput); len(matches) >= 3 {
+	if matches := sshPattern.FindStringSubmatch(remoteURL); len(matches) >= 3 {
 		repoPath := fmt.Sprintf("%s/%s", matches[1], matches[2])
 		log.V(log.Config, fmt.Sprintf("Found repository: %s (SSH)",
 			log.ColorText(log.ColorGreen, repoPath)))
@@ -82,7 +127,7 @@ func parseRemote(remoteOutput string) (*RepoInfo, error) {
 	}
 
 	// Try HTTPS pattern
-	if matches := httpsPattern.FindStringSubmatch(remoteOutput); len(matches) >= 3 {
+	if matches := httpsPattern.FindStringSubmatch(remoteURL); len(matches) >= 3 {
 		repoPath := fmt.Sprintf("%s/%s", matches[1], matches[2])
 		log.V(log.Config, fmt.Sprintf("Found repository: %s (HTTPS)",
 			log.ColorText(log.ColorGreen, repoPath)))
@@ -94,7 +139,7 @@ func parseRemote(remoteOutput string) (*RepoInfo, error) {
 
 	errors.Fatal(
 		"Invalid Remote URL",
-		"Could not parse GitHub repository information from remote.\nOnly GitHub repositories are supported.",
+		fmt.Sprintf("Could not parse GitHub repository information from %s.\nOnly GitHub repositories are supported.", remoteURL),
 		errors.ErrInvalidRemote,
 	)
 
@@ -102,16 +147,12 @@ func parseRemote(remoteOutput string) (*RepoInfo, error) {
 }
 
 func IsClean() error {
-	log.V(log.Preflight, fmt.Sprintf("%s (Check branch state)",
-		log.ColorText(log.ColorGreen, "git status --porcelain"),
-	))
-	cmd := exec.Command("git", "status", "--porcelain")
-	output, err := cmd.CombinedOutput()
+	clean, err := backend().IsClean()
 	if err != nil {
 		return fmt.Errorf("unable to check git status: %w", err)
 	}
 
-	if strings.TrimSpace(string(output)) != "" {
+	if !clean {
 		return fmt.Errorf("the working tree has uncommitted changes. Please commit or stash them")
 	}
 
@@ -120,16 +161,11 @@ func IsClean() error {
 }
 
 func EnsureNotDetached() error {
-	log.V(log.Preflight, fmt.Sprintf("%s (Ensure branch is not detached)",
-		log.ColorText(log.ColorGreen, "git rev-parse --abbrev-ref HEAD"),
-	))
-	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
-	output, err := cmd.CombinedOutput()
+	branch, err := backend().CurrentBranch()
 	if err != nil {
 		return fmt.Errorf("unable to determine HEAD state: %w", err)
 	}
 
-	branch := strings.TrimSpace(string(output))
 	if branch == "HEAD" {
 		return fmt.Errorf("detached HEAD state detected. Please checkout a branch")
 	}
@@ -139,17 +175,16 @@ func EnsureNotDetached() error {
 }
 
 func OnMainBranch() error {
-	log.V(log.Preflight, fmt.Sprintf("%s (Check on main branch)",
-		log.ColorText(log.ColorGreen, "git rev-parse --abbrev-ref HEAD"),
-	))
-
-	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
-	output, err := cmd.CombinedOutput()
+	branch, err := backend().CurrentBranch()
 	if err != nil {
 		return fmt.Errorf("unable to determine current branch: %w", err)
 	}
 
-	branch := strings.TrimSpace(string(output))
+	return CheckMainBranch(branch)
+}
+
+// CheckMainBranch verifies that the given branch is a release branch
+func CheckMainBranch(branch string) error {
 	if branch != "main" && branch != "master" {
 		return fmt.Errorf("you are on branch '%s'. Releases are only allowed from 'main' or 'master'", branch)
 	}
@@ -158,68 +193,118 @@ func OnMainBranch() error {
 	return nil
 }
 
-func HasUpstream() error {
-	log.V(log.Preflight, fmt.Sprintf("%s (Check upstream configuration)",
-		log.ColorText(log.ColorGreen, "git for-each-ref"),
+// IsDetached reports whether HEAD points to a commit instead of a branch
+func IsDetached() bool {
+	branch, err := backend().CurrentBranch()
+	return err != nil || branch == "HEAD"
+}
+
+// IsShallow reports whether the repository is a shallow clone
+func IsShallow() (bool, error) {
+	shallow, err := backend().IsShallow()
+	if err != nil {
+		return false, fmt.Errorf("unable to determine clone depth: %w", err)
+	}
+	return shallow, nil
+}
+
+// Deepen fetches depth more commits and all tags into a shallow clone
+func Deepen(depth int) error {
+	remote := Remote()
+	arg := fmt.Sprintf("--deepen=%d", depth)
+	log.V(log.Preflight, fmt.Sprintf("%s (Deepen history)",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git fetch %s --tags %s", arg, remote)),
 	))
 
-	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
-	output, err := cmd.CombinedOutput()
+	output, err := exec.Command("git", "fetch", arg, "--tags", remote).CombinedOutput()
 	if err != nil {
-		return fmt.Errorf("unable to determine current branch: %w", err)
+		return fmt.Errorf("unable to deepen repository: %s", strings.TrimSpace(string(output)))
 	}
+	return nil
+}
 
-	branch := strings.TrimSpace(string(output))
+// Unshallow fetches the complete history and all tags of a shallow clone
+func Unshallow() error {
+	remote := Remote()
+	log.V(log.Preflight, fmt.Sprintf("%s (Fetch full history)",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git fetch --unshallow --tags %s", remote)),
+	))
 
-	cmd = exec.Command(
-		"git",
-		"for-each-ref",
-		"--format=%(upstream:short)",
-		fmt.Sprintf("refs/heads/%s", branch),
-	)
+	cmd := exec.Command("git", "fetch", "--unshallow", "--tags", remote)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("unable to unshallow repository: %s", strings.TrimSpace(string(output)))
+	}
 
-	output, err = cmd.CombinedOutput()
+	log.V(log.Preflight, "Fetched full repository history")
+	return nil
+}
+
+// HasUpstream checks that the current branch exists on the release remote
+func HasUpstream() error {
+	upstream, err := upstreamRef()
 	if err != nil {
-		return fmt.Errorf("unable to determine upstream branch: %w", err)
+		return err
 	}
 
-	upstream := strings.TrimSpace(string(output))
-	if upstream == "" {
-		return fmt.Errorf("branch '%s' has no upstream configured", branch)
+	log.V(log.Preflight, fmt.Sprintf("%s (Check upstream configuration)",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("refs/remotes/%s", upstream)),
+	))
+
+	if !backend().HasRef(fmt.Sprintf("refs/remotes/%s", upstream)) {
+		remote, branch, _ := strings.Cut(upstream, "/")
+		return fmt.Errorf("branch '%s' does not exist on remote '%s'", branch, remote)
 	}
 
 	log.V(log.Preflight, fmt.Sprintf("Upstream branch: %s", log.ColorText(log.ColorGreen, upstream)))
 	return nil
 }
 
-func IsUpToDate() error {
-	log.V(log.Preflight, fmt.Sprintf("%s (Check if branch is up to date)",
-		log.ColorText(log.ColorGreen, "git status -sb"),
-	))
+// Divergence compares the current branch with its branch on the release remote
+type Divergence struct {
+	Upstream string
+	// Ahead counts local commits missing on the remote
+	Ahead int
+	// Behind counts remote commits missing locally
+	Behind int
+}
 
-	cmd := exec.Command("git", "status", "-sb")
-	output, err := cmd.CombinedOutput()
+// Diverged reports whether both sides have commits the other one is missing
+func (d Divergence) Diverged() bool {
+	return d.Ahead > 0 && d.Behind > 0
+}
+
+// CompareUpstream counts the commits the current branch is ahead of and
+// behind its branch on the release remote
+func CompareUpstream() (Divergence, error) {
+	upstream, err := upstreamRef()
 	if err != nil {
-		return fmt.Errorf("unable to check branch status: %w", err)
+		return Divergence{}, err
 	}
 
-	status := string(output)
+	ahead, behind, err := backend().AheadBehind("HEAD", upstream)
+	if err != nil {
+		return Divergence{}, fmt.Errorf("unable to compare with %s: %w", upstream, err)
+	}
+
+	log.V(log.Preflight, fmt.Sprintf("%s is %d ahead, %d behind",
+		log.ColorText(log.ColorGreen, upstream), ahead, behind))
+	return Divergence{Upstream: upstream, Ahead: ahead, Behind: behind}, nil
+}
 
-	if strings.Contains(status, "behind") {
-		return fmt.Errorf("branch is behind its upstream. Please pull the latest changes")
+// upstreamRef returns <remote>/<branch> of the current branch on the release remote
+func upstreamRef() (string, error) {
+	branch, err := backend().CurrentBranch()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine current branch: %w", err)
 	}
 
-	log.V(log.Preflight, "Branch is up to date with upstream")
-	return nil
+	return fmt.Sprintf("%s/%s", Remote(), branch), nil
 }
 
 // CurrentBranch returns the name of the current branch
 func CurrentBranch() string {
-	log.V(log.History, "Fetching current branch: "+
-		log.ColorText(log.ColorGreen, "git rev-parse --abbrev-ref HEAD"))
-
-	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
-	branchOut, err := cmd.Output()
+	branch, err := backend().CurrentBranch()
 	if err != nil {
 		errors.Fatal(
 			"Failed to get current branch",
@@ -228,8 +313,6 @@ func CurrentBranch() string {
 		)
 		return ""
 	}
-
-	branch := strings.TrimSpace(string(branchOut))
 	return branch
 }
 
@@ -255,11 +338,7 @@ func LastCommit() string {
 
 // TotalCommits returns the total number of commits as a string
 func TotalCommits() string {
-	log.V(log.History, "Counting total commits: "+
-		log.ColorText(log.ColorGreen, "git rev-list --count HEAD"))
-
-	cmd := exec.Command("git", "rev-list", "--count", "HEAD")
-	totalCommitsOut, err := cmd.Output()
+	count, err := backend().Count("", "HEAD")
 	if err != nil {
 		errors.Warning(
 			"Failed to count commits",
@@ -268,7 +347,7 @@ func TotalCommits() string {
 		return "0"
 	}
 
-	return strings.TrimSpace(string(totalCommitsOut))
+	return strconv.Itoa(count)
 }
 
 // FilesCount returns the number of tracked files
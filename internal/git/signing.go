@@ -0,0 +1,151 @@
+// Package git includes operations using git or git-cli
+package git
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// Signing formats as configured in gpg.format
+const (
+	SigningOpenPGP = "openpgp"
+	SigningSSH     = "ssh"
+	SigningX509    = "x509"
+)
+
+// ConfigValue returns a value of the effective git config, empty if unset
+func ConfigValue(key string) string {
+	output, err := exec.Command("git", "config", "--get", key).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// SigningFormat returns gpg.format, git defaults to openpgp
+func SigningFormat() string {
+	if format := ConfigValue("gpg.format"); format != "" {
+		return format
+	}
+	return SigningOpenPGP
+}
+
+// CheckSigningKey verifies that git can sign with key, or with
+// user.signingkey from the git config when key is empty
+func CheckSigningKey(key string) error {
+	if key == "" {
+		key = ConfigValue("user.signingkey")
+	}
+
+	format := SigningFormat()
+	log.V(log.Preflight, fmt.Sprintf("Checking %s signing key %s", format,
+		log.ColorText(log.ColorGreen, key)))
+
+	switch format {
+	case SigningOpenPGP:
+		return checkGPGKey(key)
+	case SigningSSH:
+		return checkSSHKey(key)
+	case SigningX509:
+		return checkProgram(ConfigValue("gpg.x509.program"), "gpgsm")
+	default:
+		return fmt.Errorf("unsupported gpg.format %q", format)
+	}
+}
+
+func checkGPGKey(key string) error {
+	program := ConfigValue("gpg.openpgp.program")
+	if program == "" {
+		program = ConfigValue("gpg.program")
+	}
+	if program == "" {
+		program = "gpg"
+	}
+
+	if err := checkProgram(program, "gpg"); err != nil {
+		return err
+	}
+
+	// without a configured key git signs with the committer identity
+	if key == "" {
+		key = ConfigValue("user.email")
+	}
+	if key == "" {
+		return fmt.Errorf("no signing key configured.\nSet one with: git config user.signingkey <key-id>")
+	}
+
+	if err := exec.Command(program, "--list-secret-keys", key).Run(); err != nil {
+		return fmt.Errorf("no secret gpg key found for %q", key)
+	}
+	return nil
+}
+
+func checkSSHKey(key string) error {
+	if err := checkProgram(ConfigValue("gpg.ssh.program"), "ssh-keygen"); err != nil {
+		return err
+	}
+
+	if key == "" {
+		if ConfigValue("gpg.ssh.defaultKeyCommand") != "" {
+			return nil
+		}
+		return fmt.Errorf("no ssh signing key configured.\nSet one with: git config user.signingkey ~/.ssh/id_ed25519.pub")
+	}
+
+	// literal keys need no file on disk
+	if !strings.HasPrefix(key, "key::") && !strings.HasPrefix(key, "ssh-") {
+		if _, err := os.Stat(expandHome(key)); err != nil {
+			return fmt.Errorf("ssh signing key %s not found", key)
+		}
+	}
+
+	// git tag -v cannot verify ssh signatures without allowed signers
+	if ConfigValue("gpg.ssh.allowedSignersFile") == "" {
+		return fmt.Errorf("gpg.ssh.allowedSignersFile is not configured, signatures cannot be verified.\nSet it with: git config gpg.ssh.allowedSignersFile ~/.ssh/allowed_signers")
+	}
+	return nil
+}
+
+func checkProgram(program, fallback string) error {
+	if program == "" {
+		program = fallback
+	}
+	if _, err := exec.LookPath(program); err != nil {
+		return fmt.Errorf("%s is not installed or not available in PATH", program)
+	}
+	return nil
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
+
+// VerifyTag checks the signature of tag
+func VerifyTag(tag string) error {
+	log.V(log.Release, fmt.Sprintf("Verifying tag signature: %s",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git tag -v %s", tag))))
+
+	output, err := exec.Command("git", "tag", "-v", tag).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("signature of %s could not be verified: %s", tag, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ package git
 import (
 	"fmt"
 	"os/exec"
-	"strconv"
 	"strings"
 
 	"github.com/nekoman-hq/neko-cli/internal/errors"
@@ -17,20 +16,19 @@ import (
 @Since      20.12.2025
 */
 
+// LatestTag returns the latest version tag. Component tags like
+// chart-1.2.0 are ignored, only v1.2.0 and 1.2.0 are considered.
 func LatestTag() string {
-	log.V(log.Release, fmt.Sprintf("%s (Extract last tag)", log.ColorText(log.ColorGreen, "git describe --tags --abbrev=0")))
-	cmd := exec.Command("git", "describe", "--tags", "--abbrev=0")
-	output, err := cmd.CombinedOutput()
+	tag, err := backend().LatestTag("v[0-9]*", "[0-9]*")
 	if err != nil {
 		errors.Warning(
 			"Failed to get latest tag",
-			"No tags found or could not execute git describe.\nUsing default version 0.1.0.",
+			fmt.Sprintf("Could not read the tags: %s.\nUsing default version 0.1.0.", err.Error()),
 		)
 		return "0.1.0"
 	}
 
-	outputStr := strings.TrimSpace(string(output))
-	if outputStr == "" {
+	if tag == "" {
 		errors.Warning(
 			"No tags found",
 			"No tags exist in this repository.\nUsing default version 0.1.0.",
@@ -38,17 +36,19 @@ func LatestTag() string {
 		return "0.1.0"
 	}
 
-	log.V(log.VersionGuard, fmt.Sprintf("Latest tag: %s", outputStr))
-	return outputStr
+	log.V(log.VersionGuard, fmt.Sprintf("Latest tag: %s", tag))
+	return tag
+}
+
+// HasVersionTag reports whether a version tag is reachable from HEAD
+func HasVersionTag() bool {
+	tag, err := backend().LatestTag("v[0-9]*", "[0-9]*")
+	return err == nil && tag != ""
 }
 
 // GetTags returns a list of all git tags
 func GetTags() []string {
-	log.V(log.History, "Fetching git tags: "+
-		log.ColorText(log.ColorGreen, "git tag"))
-
-	cmd := exec.Command("git", "tag")
-	tagsOut, err := cmd.Output()
+	tags, err := backend().Tags()
 	if err != nil {
 		errors.Warning(
 			"Failed to fetch tags",
@@ -56,30 +56,12 @@ func GetTags() []string {
 		)
 		return []string{}
 	}
-
-	tagList := strings.Split(strings.TrimSpace(string(tagsOut)), "\n")
-	if len(tagList) == 1 && tagList[0] == "" {
-		return []string{}
-	}
-
-	return tagList
+	return tags
 }
 
 // CountCommitsBetween counts commits between two references
 func CountCommitsBetween(from, to string) int {
-	var cmd *exec.Cmd
-
-	if from == "" {
-		log.V(log.History, fmt.Sprintf("Counting commits up to %s: %s",
-			to, log.ColorText(log.ColorGreen, fmt.Sprintf("git rev-list --count %s", to))))
-		cmd = exec.Command("git", "rev-list", "--count", to)
-	} else {
-		log.V(log.History, fmt.Sprintf("Counting commits between %s and %s: %s",
-			from, to, log.ColorText(log.ColorGreen, fmt.Sprintf("git rev-list --count %s..%s", from, to))))
-		cmd = exec.Command("git", "rev-list", "--count", fmt.Sprintf("%s..%s", from, to))
-	}
-
-	out, err := cmd.Output()
+	count, err := backend().Count(from, to)
 	if err != nil {
 		errors.Warning(
 			"Failed to count commits",
@@ -87,16 +69,88 @@ func CountCommitsBetween(from, to string) int {
 		)
 		return 0
 	}
+	return count
+}
 
-	countStr := strings.TrimSpace(string(out))
-	count, err := strconv.Atoi(countStr)
+// LatestTagMatching returns the latest tag matching the glob pattern
+// reachable from HEAD, or an empty string if there is none
+func LatestTagMatching(pattern string) string {
+	tag, err := backend().LatestTag(pattern)
 	if err != nil {
-		errors.Warning(
-			"Failed to parse commit count",
-			fmt.Sprintf("Invalid count value: %s", countStr),
-		)
-		return 0
+		return ""
 	}
+	return tag
+}
 
-	return count
+// ChangedSince reports whether path changed between ref and HEAD
+func ChangedSince(ref, path string) bool {
+	cmd := exec.Command("git", "diff", "--quiet", ref, "HEAD", "--", path)
+	return cmd.Run() != nil
+}
+
+// TagExists reports whether the tag exists in the local repository
+func TagExists(tag string) bool {
+	return backend().HasRef(fmt.Sprintf("refs/tags/%s", tag))
+}
+
+// RemoteTags returns the tags of the release remote mapped to the commit they
+// point at, annotated tags are peeled
+func RemoteTags() (map[string]string, error) {
+	remote := Remote()
+	log.V(log.VersionGuard, fmt.Sprintf("Listing remote tags: %s",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git ls-remote --tags %s", remote))))
+
+	output, err := exec.Command("git", "ls-remote", "--tags", remote).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("git ls-remote %s failed: %s", remote, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("git ls-remote %s failed: %w", remote, err)
+	}
+
+	tags := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		sha, ref, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+
+		name := strings.TrimPrefix(ref, "refs/tags/")
+		if peeled, ok := strings.CutSuffix(name, "^{}"); ok {
+			tags[peeled] = sha
+			continue
+		}
+		if _, ok := tags[name]; !ok {
+			tags[name] = sha
+		}
+	}
+	return tags, nil
+}
+
+// ResolveCommit returns the full hash of the commit rev points at
+func ResolveCommit(rev string) (string, error) {
+	return backend().Resolve(rev)
+}
+
+// TagNotes returns the message of an annotated tag without its signature
+func TagNotes(tag string) (string, error) {
+	if !TagExists(tag) {
+		return "", fmt.Errorf("tag %s does not exist locally", tag)
+	}
+
+	log.V(log.History, fmt.Sprintf("Reading tag message: %s",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git cat-file -t %s", tag))))
+
+	kind, err := exec.Command("git", "cat-file", "-t", fmt.Sprintf("refs/tags/%s", tag)).Output()
+	if err != nil || strings.TrimSpace(string(kind)) != "tag" {
+		return "", fmt.Errorf("%s is a lightweight tag without release notes", tag)
+	}
+
+	output, err := exec.Command("git", "for-each-ref",
+		"--format=%(contents:subject)%0a%0a%(contents:body)",
+		fmt.Sprintf("refs/tags/%s", tag)).Output()
+	if err != nil {
+		return "", fmt.Errorf("reading tag %s failed: %w", tag, err)
+	}
+	return strings.TrimSpace(string(output)), nil
 }
@@ -21,7 +21,7 @@ import (
 
 func LatestRelease(repoInfo *RepoInfo) *github.Release {
 	token := config.GetPAT()
-	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases/latest", repoInfo.Owner, repoInfo.Repo)
+	url := fmt.Sprintf("%s/repos/%s/%s/releases/latest", config.GetAPIURL(), repoInfo.Owner, repoInfo.Repo)
 
 	log.V(log.Release, fmt.Sprintf("Fetching latest release from remote: %s",
 		log.ColorText(log.ColorGreen, url),
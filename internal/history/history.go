@@ -9,6 +9,7 @@ package history
 import (
 	"fmt"
 
+	"github.com/nekoman-hq/neko-cli/internal/errors"
 	"github.com/nekoman-hq/neko-cli/internal/git"
 	"github.com/nekoman-hq/neko-cli/internal/log"
 )
@@ -27,6 +28,22 @@ func ShowHistory() {
 		log.ColorText(log.ColorGreen, "completed"))
 }
 
+// ShowTagNotes prints the release notes stored in an annotated tag. Only the
+// local repository is read, no network access is needed.
+func ShowTagNotes(tag string) {
+	notes, err := git.TagNotes(tag)
+	if err != nil {
+		errors.Fatal(
+			"Release notes unavailable",
+			err.Error(),
+			errors.ErrTagNotFound,
+		)
+	}
+
+	fmt.Printf("%s  %s\n\n", log.ColorText(log.ColorGreen, "\U000F04F9"), log.ColorText(log.ColorPurple, tag))
+	fmt.Println(notes)
+}
+
 // showBranch displays the current branch
 func showBranch() {
 	branch := git.CurrentBranch()
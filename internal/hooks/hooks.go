@@ -0,0 +1,119 @@
+// Package hooks runs the user defined commands around the release stages
+package hooks
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// Runner holds the hooks of a release and the values exposed to them
+type Runner struct {
+	hooks           map[config.HookStage][]config.Hook
+	releaseSystem   string
+	version         string
+	previousVersion string
+}
+
+func NewRunner(cfg *config.NekoConfig) *Runner {
+	return &Runner{
+		hooks:           cfg.Hooks,
+		releaseSystem:   string(cfg.ReleaseSystem),
+		previousVersion: cfg.Version,
+	}
+}
+
+// SetVersion sets the version being released, hooks before this call only
+// know the previous version
+func (r *Runner) SetVersion(version string) {
+	r.version = version
+}
+
+// Run executes the hooks of stage in order. A failing hook aborts the release.
+func (r *Runner) Run(stage config.HookStage) {
+	for _, hook := range r.hooks[stage] {
+		if err := r.run(stage, hook, nil); err != nil {
+			errors.Fatal(
+				"Hook failed",
+				fmt.Sprintf("%s hook %q failed: %s", stage, hook.Run, err.Error()),
+				errors.ErrHookFailed,
+			)
+		}
+	}
+}
+
+// RunOnFailure executes the on-failure hooks. Their failures are only
+// reported, the release has already failed.
+func (r *Runner) RunOnFailure(failure errors.CLIError) {
+	env := []string{
+		"NEKO_ERROR=" + failure.Title,
+		"NEKO_ERROR_CODE=" + failure.Code,
+	}
+
+	for _, hook := range r.hooks[config.HookOnFailure] {
+		if err := r.run(config.HookOnFailure, hook, env); err != nil {
+			errors.Warning(
+				"Hook failed",
+				fmt.Sprintf("on-failure hook %q failed: %s", hook.Run, err.Error()),
+			)
+		}
+	}
+}
+
+func (r *Runner) run(stage config.HookStage, hook config.Hook, extra []string) error {
+	timeout, err := hook.Duration()
+	if err != nil {
+		return err
+	}
+
+	log.Print(log.Release, "Running %s hook: %s",
+		log.ColorText(log.ColorPurple, string(stage)),
+		log.ColorText(log.ColorGreen, hook.Run))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := shell(ctx, hook.Run)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(append(os.Environ(), r.env()...), extra...)
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+	return err
+}
+
+func (r *Runner) env() []string {
+	tag := ""
+	if r.version != "" {
+		tag = "v" + r.version
+	}
+
+	return []string{
+		"NEKO_VERSION=" + r.version,
+		"NEKO_PREVIOUS_VERSION=" + r.previousVersion,
+		"NEKO_TAG=" + tag,
+		"NEKO_RELEASE_SYSTEM=" + r.releaseSystem,
+	}
+}
+
+func shell(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
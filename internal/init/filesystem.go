@@ -9,22 +9,29 @@ package init
 import (
 	"os"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
 )
 
-func confirmOverwriteIfExists() bool {
+func confirmOverwriteIfExists(force bool) bool {
 	if _, err := os.Stat(".neko.json"); err != nil {
 		return true
 	}
 
-	var overwrite bool
-	confirm := &survey.Confirm{
-		Message: ".neko.json already exists. Overwrite it?",
-		Default: false,
+	if force {
+		log.V(log.Init, "Overwriting existing .neko.json (--force)")
+		return true
 	}
 
-	if err := survey.AskOne(confirm, &overwrite); err != nil {
+	overwrite, err := prompt.Confirm(prompt.Question{
+		Message: ".neko.json already exists. Overwrite it?",
+		Default: "false",
+		Flag:    "force",
+		Env:     "NEKO_FORCE",
+	})
+
+	if err != nil {
 		errors.Warning(
 			"Initialization cancelled",
 			"Configuration wizard was aborted by the user.",
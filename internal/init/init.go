@@ -14,12 +14,25 @@ import (
 	"github.com/nekoman-hq/neko-cli/internal/release"
 )
 
-func Run(info *git.RepoInfo) {
-	if !confirmOverwriteIfExists() {
+func Run(info *git.RepoInfo, opts Options) {
+	if !confirmOverwriteIfExists(opts.Force) {
 		return
 	}
 
-	cfg := runWizard()
+	base := &config.NekoConfig{}
+	if opts.Template != "" {
+		base = applyTemplate(&opts)
+	}
+	release.RegisterPlugins(base)
+
+	var cfg config.NekoConfig
+	if opts.complete() {
+		cfg = *base
+		opts.apply(&cfg)
+		config.Validate(&cfg)
+	} else {
+		cfg = runWizard(base, opts)
+	}
 
 	if info != nil {
 		cfg.ProjectOwner = info.Owner
@@ -44,6 +57,8 @@ func Run(info *git.RepoInfo) {
 		)
 	}
 
+	releaser.Configure(&cfg)
+
 	err = releaser.Init(&cfg)
 	if err != nil {
 		errors.Fatal(
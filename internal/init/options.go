@@ -0,0 +1,43 @@
+package init
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import "github.com/nekoman-hq/neko-cli/internal/config"
+
+// Options holds the values given to neko init by flags
+type Options struct {
+	ProjectType   string
+	ReleaseSystem string
+	Version       string
+	Force         bool
+	Template      string
+}
+
+// complete reports whether all wizard answers are known
+func (o *Options) complete() bool {
+	return o.ProjectType != "" && o.ReleaseSystem != "" && o.Version != ""
+}
+
+// seed fills all options not given by flags from the template configuration
+func (o *Options) seed(tpl *config.NekoConfig) {
+	if o.ProjectType == "" {
+		o.ProjectType = string(tpl.ProjectType)
+	}
+	if o.ReleaseSystem == "" {
+		o.ReleaseSystem = string(tpl.ReleaseSystem)
+	}
+	if o.Version == "" {
+		o.Version = tpl.Version
+	}
+}
+
+// apply writes the options into the configuration
+func (o *Options) apply(cfg *config.NekoConfig) {
+	cfg.ProjectType = config.ProjectType(o.ProjectType)
+	cfg.ReleaseSystem = config.ReleaseSystem(o.ReleaseSystem)
+	cfg.Version = o.Version
+}
@@ -29,9 +29,32 @@ func printSetupInstructions(cfg config.NekoConfig) {
 	case config.ReleaseTypeJReleaser:
 		println("    jreleaser.yml")
 		println("    pom.xml / build.gradle")
+	case config.ReleaseTypeMaven:
+		println("    pom.xml (all reactor modules)")
+		println("    Git tags")
+	case config.ReleaseTypeGradle:
+		println("    gradle.properties / build.gradle(.kts)")
+		println("    Git tags")
+	case config.ReleaseTypeCargo:
+		println("    Cargo.toml (workspace aware)")
+		println("    Cargo.lock")
+	case config.ReleaseTypeHelm:
+		println("    Chart.yaml (version, optionally appVersion)")
+		println("    Git tags (per chart in multi-chart repositories)")
+	case config.ReleaseTypePython:
+		println("    pyproject.toml ([project] / [tool.poetry])")
+		println("    __version__ files from tools.python.version-files")
 	case config.ReleaseTypeGoReleaser:
 		println("    .goreleaser.yml")
 		println("    Git tags")
+	case config.ReleaseTypeSemanticRelease:
+		println("    .releaserc.json")
+		println("    Git tags (computed by semantic-release, validated by neko)")
+	case config.ReleaseTypeNeko:
+		println("    Git tags")
+		println("    Hosted releases (built-in changelog, no external tool)")
+	default:
+		println(fmt.Sprintf("    Files managed by the plugin %s", cfg.ReleaseSystem))
 	}
 
 	println(fmt.Sprintf("\n%s The version in %s is the single source of truth.",
@@ -7,22 +7,27 @@ package init
 */
 
 import (
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/detect"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
 )
 
-func askProjectType(cfg *config.NekoConfig) {
-	var input string
+func askProjectType(cfg *config.NekoConfig, value string, detected *detect.Result) {
+	options := make([]string, 0, len(config.ProjectTypes))
+	for _, t := range config.ProjectTypes {
+		options = append(options, string(t))
+	}
 
-	err := survey.AskOne(&survey.Select{
+	input, err := prompt.Select(prompt.Question{
 		Message: "What kind of project is this?",
-		Options: []string{
-			string(config.ProjectTypeFrontend),
-			string(config.ProjectTypeBackend),
-			string(config.ProjectTypeOther),
-		},
-	}, &input)
+		Help:    "The project type is descriptive only, every release system can be used with it.",
+		Options: options,
+		Default: string(detected.ProjectType),
+		Value:   value,
+		Flag:    "project-type",
+		Env:     "NEKO_PROJECT_TYPE",
+	})
 
 	if err != nil {
 		errors.Error(
@@ -6,17 +6,20 @@ package init
 @Since      23.12.2025
 */
 
-import "github.com/nekoman-hq/neko-cli/internal/config"
+import (
+	"sort"
 
-func releaseOptionsFor(projectType config.ProjectType) []string {
-	switch projectType {
-	case config.ProjectTypeFrontend:
-		return []string{string(config.ReleaseTypeReleaseIt)}
-	case config.ProjectTypeBackend:
-		return []string{string(config.ReleaseTypeJReleaser)}
-	case config.ProjectTypeOther:
-		return []string{string(config.ReleaseTypeGoReleaser)}
-	default:
-		return nil
-	}
+	"github.com/nekoman-hq/neko-cli/internal/detect"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+// releaseOptionsFor returns every registered release system, the best fit
+// for the detected project first
+func releaseOptionsFor(detected *detect.Result) []string {
+	options := release.Names()
+
+	sort.SliceStable(options, func(i, j int) bool {
+		return detected.Fit(options[i]) > detected.Fit(options[j])
+	})
+	return options
 }
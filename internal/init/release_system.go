@@ -7,18 +7,23 @@ package init
 */
 
 import (
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/detect"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
 )
 
-func askReleaseSystem(cfg *config.NekoConfig) {
-	var input string
+func askReleaseSystem(cfg *config.NekoConfig, value string, detected *detect.Result) {
+	options := releaseOptionsFor(detected)
 
-	err := survey.AskOne(&survey.Select{
+	input, err := prompt.Select(prompt.Question{
 		Message: "Which release system should be used?",
-		Options: releaseOptionsFor(cfg.ProjectType),
-	}, &input)
+		Options: options,
+		Default: preselect(options, string(detected.ReleaseSystem)),
+		Value:   value,
+		Flag:    "release-system",
+		Env:     "NEKO_RELEASE_SYSTEM",
+	})
 	if err != nil {
 		errors.Error(
 			"Release system selection failed",
@@ -29,7 +34,7 @@ func askReleaseSystem(cfg *config.NekoConfig) {
 	}
 
 	cfg.ReleaseSystem = config.ReleaseSystem(input)
-	if !cfg.ReleaseSystem.IsValid() {
+	if !cfg.HasReleaseSystem() {
 		errors.Error(
 			"Invalid release system",
 			"Selected release system is not supported.",
@@ -38,3 +43,13 @@ func askReleaseSystem(cfg *config.NekoConfig) {
 		return
 	}
 }
+
+// preselect returns the detected option if it is offered, otherwise the first option
+func preselect(options []string, detected string) string {
+	for _, option := range options {
+		if option == detected {
+			return option
+		}
+	}
+	return options[0]
+}
@@ -0,0 +1,170 @@
+package init
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+const templateConfigName = ".neko.json"
+
+// template is an org-wide directory holding a .neko.json and tool configs
+type template struct {
+	dir    string
+	config string
+}
+
+// resolveTemplate accepts a template directory, a template .neko.json or a
+// file:// URL pointing to either of them
+func resolveTemplate(source string) (*template, error) {
+	path := source
+	if strings.HasPrefix(source, "file://") {
+		u, err := url.Parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template url %s: %w", source, err)
+		}
+		path = u.Path
+	} else if strings.Contains(source, "://") {
+		return nil, fmt.Errorf("template %s is not a local path, only file:// urls are supported", source)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("template %s not accessible: %w", path, err)
+	}
+
+	if info.IsDir() {
+		return &template{dir: path, config: filepath.Join(path, templateConfigName)}, nil
+	}
+	return &template{dir: filepath.Dir(path), config: path}, nil
+}
+
+// loadConfig reads the template configuration. Missing values are allowed,
+// they are asked by the wizard or given by flags.
+func (t *template) loadConfig() (*config.NekoConfig, error) {
+	data, err := os.ReadFile(t.config)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &config.NekoConfig{}, nil
+		}
+		return nil, fmt.Errorf("read template config: %w", err)
+	}
+
+	var cfg config.NekoConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse template config %s: %w", t.config, err)
+	}
+
+	log.V(log.Init, fmt.Sprintf("Loaded template configuration %s",
+		log.ColorText(log.ColorGreen, t.config)))
+	return &cfg, nil
+}
+
+// copyToolConfigs copies all files of the template directory except the
+// template configuration into the working directory
+func (t *template) copyToolConfigs(force bool) error {
+	entries, err := os.ReadDir(t.dir)
+	if err != nil {
+		return fmt.Errorf("read template directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		src := filepath.Join(t.dir, entry.Name())
+		if !entry.Type().IsRegular() || sameFile(src, t.config) {
+			continue
+		}
+
+		if _, err := os.Stat(entry.Name()); err == nil && !force {
+			log.Print(log.Init, "Skipping template file %s, it already exists",
+				log.ColorText(log.ColorCyan, entry.Name()))
+			continue
+		}
+
+		if err := copyFile(src, entry.Name()); err != nil {
+			return err
+		}
+
+		log.Print(log.Init, "\uF00C Copied %s from template",
+			log.ColorText(log.ColorCyan, entry.Name()))
+	}
+	return nil
+}
+
+func sameFile(a, b string) bool {
+	infoA, errA := os.Stat(a)
+	infoB, errB := os.Stat(b)
+	return errA == nil && errB == nil && os.SameFile(infoA, infoB)
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", src, err)
+	}
+	defer func() {
+		if cerr := in.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close %s: %w", src, cerr)
+		}
+	}()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", dst, err)
+	}
+	defer func() {
+		if cerr := out.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close %s: %w", dst, cerr)
+		}
+	}()
+
+	if _, err = io.Copy(out, in); err != nil {
+		return fmt.Errorf("copy %s: %w", src, err)
+	}
+	return nil
+}
+
+// applyTemplate seeds the options and working directory from the template
+func applyTemplate(opts *Options) *config.NekoConfig {
+	tpl, err := resolveTemplate(opts.Template)
+	if err != nil {
+		errors.Fatal(
+			"Template not found",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	tplCfg, err := tpl.loadConfig()
+	if err != nil {
+		errors.Fatal(
+			"Template configuration invalid",
+			err.Error(),
+			errors.ErrConfigRead,
+		)
+	}
+
+	if err := tpl.copyToolConfigs(opts.Force); err != nil {
+		errors.Fatal(
+			"Template copy failed",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	opts.seed(tplCfg)
+	return tplCfg
+}
@@ -7,17 +7,26 @@ package init
 */
 
 import (
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/detect"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
 )
 
-func askInitialVersion(cfg *config.NekoConfig) {
-	err := survey.AskOne(&survey.Input{
+func askInitialVersion(cfg *config.NekoConfig, value string, detected *detect.Result) {
+	defaultVersion := "0.1.0"
+	if detected.Version != "" {
+		defaultVersion = detected.Version
+	}
+
+	version, err := prompt.Input(prompt.Question{
 		Message: "Initial version:",
-		Default: "0.1.0",
+		Default: defaultVersion,
 		Help:    "Semantic Versioning (MAJOR.MINOR.PATCH)",
-	}, &cfg.Version)
+		Value:   value,
+		Flag:    "version",
+		Env:     "NEKO_VERSION",
+	})
 	if err != nil {
 		errors.Error(
 			"Version input failed",
@@ -26,4 +35,6 @@ func askInitialVersion(cfg *config.NekoConfig) {
 		)
 		return
 	}
+
+	cfg.Version = version
 }
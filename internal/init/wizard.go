@@ -6,15 +6,35 @@ package init
 @Since      23.12.2025
 */
 
-import "github.com/nekoman-hq/neko-cli/internal/config"
+import (
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/detect"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
 
-func runWizard() config.NekoConfig {
-	cfg := config.NekoConfig{}
+func runWizard(base *config.NekoConfig, opts Options) config.NekoConfig {
+	cfg := *base
 
-	askProjectType(&cfg)
-	askReleaseSystem(&cfg)
-	askInitialVersion(&cfg)
+	detected := detect.Run(".")
+	explainDetection(detected)
+
+	askProjectType(&cfg, opts.ProjectType, detected)
+	askReleaseSystem(&cfg, opts.ReleaseSystem, detected)
+	askInitialVersion(&cfg, opts.Version, detected)
 
 	config.Validate(&cfg)
 	return cfg
 }
+
+// explainDetection prints why the wizard pre-selects its defaults
+func explainDetection(detected *detect.Result) {
+	if len(detected.Reasons) == 0 {
+		log.V(log.Init, "Nothing detected, no defaults are pre-selected")
+		return
+	}
+
+	log.Print(log.Init, "Detected project setup:")
+	for _, reason := range detected.Reasons {
+		log.Print(log.Init, "  %s %s", log.ColorText(log.ColorCyan, "\uF101"), reason)
+	}
+}
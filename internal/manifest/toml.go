@@ -0,0 +1,110 @@
+// Package manifest reads and updates version declarations in project manifests
+package manifest
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	tableRegex = regexp.MustCompile(`^\s*\[\[?\s*([^\]]+?)\s*\]\]?\s*(?:#.*)?$`)
+	quoteRegex = regexp.MustCompile(`"([^"]*)"`)
+)
+
+// TOMLValue returns the string value of key in table. The root table is "".
+// Only plain string values on a single line are supported, which covers the
+// version declarations of Cargo.toml and pyproject.toml.
+func TOMLValue(content, table, key string) (string, bool) {
+	keyRegex := tomlKeyRegex(key)
+	current := ""
+
+	for _, line := range strings.Split(content, "\n") {
+		if m := tableRegex.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			continue
+		}
+
+		if current != table {
+			continue
+		}
+
+		if m := keyRegex.FindStringSubmatch(line); m != nil {
+			return m[2], true
+		}
+	}
+	return "", false
+}
+
+// SetTOMLValue replaces the string value of key in table and reports whether
+// the content changed. Formatting and comments are preserved.
+func SetTOMLValue(content, table, key, value string) (string, bool) {
+	keyRegex := tomlKeyRegex(key)
+	lines := strings.Split(content, "\n")
+	current := ""
+
+	for i, line := range lines {
+		if m := tableRegex.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			continue
+		}
+
+		if current != table {
+			continue
+		}
+
+		loc := keyRegex.FindStringSubmatchIndex(line)
+		if loc == nil {
+			continue
+		}
+
+		updated := line[:loc[4]] + value + line[loc[5]:]
+		if updated == line {
+			return content, false
+		}
+
+		lines[i] = updated
+		return strings.Join(lines, "\n"), true
+	}
+	return content, false
+}
+
+// TOMLStrings returns the strings of an array value, which may span several lines
+func TOMLStrings(content, table, key string) []string {
+	start := regexp.MustCompile(`^\s*` + regexp.QuoteMeta(key) + `\s*=\s*\[`)
+	current := ""
+	collecting := false
+	var values []string
+
+	for _, line := range strings.Split(content, "\n") {
+		if !collecting {
+			if m := tableRegex.FindStringSubmatch(line); m != nil {
+				current = m[1]
+				continue
+			}
+			if current != table || !start.MatchString(line) {
+				continue
+			}
+			collecting = true
+		}
+
+		code, _, _ := strings.Cut(line, "#")
+		for _, m := range quoteRegex.FindAllStringSubmatch(code, -1) {
+			values = append(values, m[1])
+		}
+
+		if strings.Contains(code, "]") {
+			break
+		}
+	}
+	return values
+}
+
+func tomlKeyRegex(key string) *regexp.Regexp {
+	return regexp.MustCompile(`^(\s*` + regexp.QuoteMeta(key) + `\s*=\s*")([^"]*)(")`)
+}
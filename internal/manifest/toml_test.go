@@ -0,0 +1,155 @@
+package manifest
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"reflect"
+	"testing"
+)
+
+const cargoManifest = `# demo crate
+name = "root-key"
+
+[package] # the crate
+name = "demo"
+# version = "0.0.1"
+version = "1.0.0" # bumped by neko
+versions = "keep"
+
+[workspace.package]
+version    =   "1.0.0"
+
+[[bin]]
+name = "demo-cli"
+version = "9.9.9"
+
+[dependencies]
+serde = { version = "1.0.0" }
+`
+
+func TestTOMLValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		table  string
+		key    string
+		want   string
+		wantOK bool
+	}{
+		{name: "root table", key: "name", want: "root-key", wantOK: true},
+		{name: "table with comment", table: "package", key: "version", want: "1.0.0", wantOK: true},
+		{name: "dotted table", table: "workspace.package", key: "version", want: "1.0.0", wantOK: true},
+		{name: "array of tables", table: "bin", key: "name", want: "demo-cli", wantOK: true},
+		{name: "inline table is no value", table: "dependencies", key: "version"},
+		{name: "missing key", table: "package", key: "edition"},
+		{name: "missing table", table: "tool.poetry", key: "version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TOMLValue(cargoManifest, tt.table, tt.key)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("TOMLValue() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSetTOMLValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		table       string
+		want        string
+		wantChanged bool
+	}{
+		{
+			name:        "keeps comments and the commented out version",
+			content:     "[package]\n# version = \"0.0.1\"\nversion = \"1.0.0\" # bumped by neko\n",
+			table:       "package",
+			want:        "[package]\n# version = \"0.0.1\"\nversion = \"1.1.0\" # bumped by neko\n",
+			wantChanged: true,
+		},
+		{
+			name:        "keeps the spacing",
+			content:     "[workspace.package]\nversion    =   \"1.0.0\"\n",
+			table:       "workspace.package",
+			want:        "[workspace.package]\nversion    =   \"1.1.0\"\n",
+			wantChanged: true,
+		},
+		{
+			name:        "only the given table",
+			content:     "[[bin]]\nversion = \"1.0.0\"\n\n[package]\nversion = \"1.0.0\"\n",
+			table:       "package",
+			want:        "[[bin]]\nversion = \"1.0.0\"\n\n[package]\nversion = \"1.1.0\"\n",
+			wantChanged: true,
+		},
+		{
+			name:    "inherited workspace version",
+			content: "[package]\nname = \"member\"\nversion.workspace = true\n",
+			table:   "package",
+			want:    "[package]\nname = \"member\"\nversion.workspace = true\n",
+		},
+		{
+			name:    "similar key",
+			content: "[package]\nversions = \"1.0.0\"\n",
+			table:   "package",
+			want:    "[package]\nversions = \"1.0.0\"\n",
+		},
+		{
+			name:    "already up to date",
+			content: "[package]\nversion = \"1.1.0\"\n",
+			table:   "package",
+			want:    "[package]\nversion = \"1.1.0\"\n",
+		},
+		{
+			name:    "missing table",
+			content: "[dependencies]\nversion = \"1.0.0\"\n",
+			table:   "package",
+			want:    "[dependencies]\nversion = \"1.0.0\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := SetTOMLValue(tt.content, tt.table, "version", "1.1.0")
+			if got != tt.want || changed != tt.wantChanged {
+				t.Errorf("SetTOMLValue() = %q, %v, want %q, %v", got, changed, tt.want, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestTOMLStrings(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "single line",
+			content: "[workspace]\nmembers = [\"crates/*\", \"cli\"]\n",
+			want:    []string{"crates/*", "cli"},
+		},
+		{
+			name:    "multi line with comments",
+			content: "[workspace]\nmembers = [\n  \"api\", # the server\n  # \"old\",\n  \"cli\",\n]\nexclude = [\"tmp\"]\n",
+			want:    []string{"api", "cli"},
+		},
+		{
+			name:    "other table",
+			content: "[package]\nmembers = [\"api\"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TOMLStrings(tt.content, "workspace", "members"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TOMLStrings() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
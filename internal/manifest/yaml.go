@@ -0,0 +1,46 @@
+package manifest
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// YAMLValue returns the scalar value of a top-level key, quotes are removed.
+// Only single line scalars are supported, which covers Chart.yaml versions.
+func YAMLValue(content, key string) (string, bool) {
+	m := yamlKeyRegex(key).FindStringSubmatch(content)
+	if m == nil {
+		return "", false
+	}
+	return m[3], true
+}
+
+// SetYAMLValue replaces the scalar value of a top-level key and keeps its
+// quoting and trailing comment. Missing keys are appended as quoted string.
+func SetYAMLValue(content, key, value string) (string, bool) {
+	re := yamlKeyRegex(key)
+
+	loc := re.FindStringSubmatchIndex(content)
+	if loc == nil {
+		if !strings.HasSuffix(content, "\n") && content != "" {
+			content += "\n"
+		}
+		return content + fmt.Sprintf("%s: %q\n", key, value), true
+	}
+
+	if content[loc[6]:loc[7]] == value {
+		return content, false
+	}
+	return content[:loc[6]] + value + content[loc[7]:], true
+}
+
+func yamlKeyRegex(key string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^(` + regexp.QuoteMeta(key) + `:[ \t]*)(["']?)([^"'\s#]+)(["']?)`)
+}
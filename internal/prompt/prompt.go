@@ -0,0 +1,220 @@
+// Package prompt wraps survey so every question can be answered by flag or environment variable
+package prompt
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/nekoman-hq/neko-cli/internal/ci"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"golang.org/x/term"
+)
+
+var (
+	// NonInteractive disables all prompts, set by --non-interactive
+	NonInteractive = false
+	// AssumeYes answers every prompt with its default, set by --yes
+	AssumeYes = false
+)
+
+// Question describes a single prompt and where its answer may come from
+type Question struct {
+	Message string
+	Help    string
+	Options []string
+	Default string
+	// Value is the answer given by a command line flag
+	Value string
+	// Flag is the name of the flag providing Value, used in error messages
+	Flag string
+	// Env is the environment variable that may hold the answer
+	Env string
+}
+
+// Interactive reports whether prompts may be shown to the user
+func Interactive() bool {
+	if NonInteractive || AssumeYes || envEnabled("NEKO_NON_INTERACTIVE") || ci.IsCI() {
+		return false
+	}
+	return stdinIsTerminal()
+}
+
+// Answer returns the answer given by flag or environment variable
+func Answer(q Question) (string, bool) {
+	if value := strings.TrimSpace(q.Value); value != "" {
+		return value, true
+	}
+
+	if q.Env == "" {
+		return "", false
+	}
+
+	if value := strings.TrimSpace(os.Getenv(q.Env)); value != "" {
+		log.V(log.Config, fmt.Sprintf("Answering %q from %s",
+			q.Message, log.ColorText(log.ColorGreen, q.Env)))
+		return value, true
+	}
+	return "", false
+}
+
+// Select asks the user to choose one of the options
+func Select(q Question) (string, error) {
+	if answer, ok := Answer(q); ok {
+		return matchOption(q, answer)
+	}
+
+	if !Interactive() {
+		return fallback(q), nil
+	}
+
+	var choice string
+	prompt := &survey.Select{
+		Message: q.Message,
+		Options: q.Options,
+		Help:    q.Help,
+	}
+	if q.Default != "" {
+		prompt.Default = q.Default
+	}
+
+	err := survey.AskOne(prompt, &choice)
+	return choice, err
+}
+
+// Input asks the user for a free text answer
+func Input(q Question) (string, error) {
+	if answer, ok := Answer(q); ok {
+		return answer, nil
+	}
+
+	if !Interactive() {
+		return fallback(q), nil
+	}
+
+	var input string
+	err := survey.AskOne(&survey.Input{
+		Message: q.Message,
+		Default: q.Default,
+		Help:    q.Help,
+	}, &input)
+	return input, err
+}
+
+// Confirm asks a yes/no question. --yes answers it with its default, or
+// confirms it when there is none.
+func Confirm(q Question) (bool, error) {
+	if answer, ok := Answer(q); ok {
+		return parseBool(q, answer), nil
+	}
+
+	if AssumeYes {
+		if q.Default != "" {
+			return parseBool(q, q.Default), nil
+		}
+		return true, nil
+	}
+
+	if !Interactive() {
+		return parseBool(q, fallback(q)), nil
+	}
+
+	var confirmed bool
+	err := survey.AskOne(&survey.Confirm{
+		Message: q.Message,
+		Default: parseBool(q, q.Default),
+		Help:    q.Help,
+	}, &confirmed)
+	return confirmed, err
+}
+
+// fallback returns the default answer when --yes is set and fails otherwise
+func fallback(q Question) string {
+	if AssumeYes && q.Default != "" {
+		log.V(log.Config, fmt.Sprintf("Using default %s for %q",
+			log.ColorText(log.ColorGreen, q.Default), q.Message))
+		return q.Default
+	}
+
+	errors.Fatal(
+		"Missing answer in non-interactive mode",
+		fmt.Sprintf("%q cannot be asked in non-interactive mode.\n%s", q.Message, hint(q)),
+		errors.ErrSurveyFailed,
+	)
+	return ""
+}
+
+func hint(q Question) string {
+	var sources []string
+	if q.Flag != "" {
+		sources = append(sources, "--"+q.Flag)
+	}
+	if q.Env != "" {
+		sources = append(sources, q.Env)
+	}
+	if q.Default != "" {
+		sources = append(sources, "--yes")
+	}
+
+	if len(sources) == 0 {
+		return "Run the command in an interactive terminal."
+	}
+	return "Provide the answer with " + strings.Join(sources, " or ") + "."
+}
+
+func matchOption(q Question, answer string) (string, error) {
+	if len(q.Options) == 0 {
+		return answer, nil
+	}
+
+	for _, option := range q.Options {
+		if strings.EqualFold(option, answer) {
+			return option, nil
+		}
+	}
+
+	errors.Fatal(
+		"Invalid answer",
+		fmt.Sprintf("%q is not a valid answer for %q.\nValid options: %s",
+			answer, q.Message, strings.Join(q.Options, ", ")),
+		errors.ErrSurveyFailed,
+	)
+	return "", nil
+}
+
+func parseBool(q Question, value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "n", "no", "false", "0":
+		return false
+	case "y", "yes", "true", "1":
+		return true
+	default:
+		errors.Fatal(
+			"Invalid answer",
+			fmt.Sprintf("%q is not a valid yes/no answer for %q", value, q.Message),
+			errors.ErrSurveyFailed,
+		)
+		return false
+	}
+}
+
+func envEnabled(name string) bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(name))) {
+	case "1", "true", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+func stdinIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
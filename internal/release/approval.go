@@ -0,0 +1,192 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
+)
+
+// maxSummaryCommits bounds the commits listed in the release summary
+const maxSummaryCommits = 20
+
+// approveEnv approves a release without a terminal, like --yes
+const approveEnv = "NEKO_APPROVE"
+
+// releaseFileLister is implemented by tools that know the files their
+// release modifies before changing them
+type releaseFileLister interface {
+	ReleaseFiles() []string
+}
+
+// Approve shows what the release of v will change and asks for confirmation
+// before the first side effect, finalize asks before tagging the merge commit.
+// old is nil when nothing was released yet. Every checklist item of .neko.json has to be
+// acknowledged. --yes or NEKO_APPROVE approve without asking, without a
+// terminal one of them is required.
+func Approve(cfg *config.NekoConfig, releaser Tool, old, v *semver.Version) {
+	printSummary(cfg, releaser, old, v)
+
+	if prompt.AssumeYes {
+		log.V(log.Release, "Release approved by --yes")
+		return
+	}
+
+	if answer, ok := prompt.Answer(prompt.Question{Message: "Approve release", Env: approveEnv}); ok {
+		approved, err := prompt.Confirm(prompt.Question{Message: "Approve release", Value: answer})
+		if err != nil || !approved {
+			notApproved(fmt.Sprintf("%s=%s declines the release", approveEnv, answer))
+		}
+		return
+	}
+
+	if !prompt.Interactive() {
+		notApproved(fmt.Sprintf("The release cannot be approved in non-interactive mode.\nApprove the release with --yes or %s=true.", approveEnv))
+	}
+
+	for _, item := range cfg.ApprovalChecklist() {
+		done, err := prompt.Confirm(prompt.Question{
+			Message: item,
+			Help:    "Checklist item of approval in .neko.json",
+			Default: "no",
+		})
+		if err != nil {
+			notApproved(err.Error())
+		}
+		if !done {
+			notApproved(fmt.Sprintf("Checklist item not acknowledged: %s", item))
+		}
+	}
+
+	approved, err := prompt.Confirm(prompt.Question{
+		Message: fmt.Sprintf("Release %s now?", v),
+		Default: "no",
+	})
+	if err != nil {
+		notApproved(err.Error())
+	}
+	if !approved {
+		notApproved("The release was declined, nothing has been changed")
+	}
+
+	log.Print(log.Release, "\uF00C Release approved")
+}
+
+func printSummary(cfg *config.NekoConfig, releaser Tool, old, v *semver.Version) {
+	log.Print(log.Release, "Release summary")
+	if old != nil {
+		log.Print(log.Release, "  Version  %s \uF178 %s",
+			log.ColorText(log.ColorCyan, old.String()),
+			log.ColorText(log.ColorGreen, v.String()))
+	} else {
+		log.Print(log.Release, "  Version  %s", log.ColorText(log.ColorGreen, v.String()))
+	}
+
+	tag := fmt.Sprintf("v%s", v)
+	switch strategy.phase {
+	case phasePrepare:
+		log.Print(log.Release, "  Tag      %s, created by neko release finalize", log.ColorText(log.ColorCyan, tag))
+		log.Print(log.Release, "  Remote   %s, pull request %s \uF178 %s",
+			log.ColorText(log.ColorCyan, git.Remote()),
+			log.ColorText(log.ColorCyan, ReleaseBranch(v)),
+			log.ColorText(log.ColorCyan, strategy.base))
+	case phaseFinalize:
+		log.Print(log.Release, "  Tag      %s on merge commit %s",
+			log.ColorText(log.ColorCyan, tag),
+			log.ColorText(log.ColorGreen, strategy.target))
+		log.Print(log.Release, "  Remote   %s", log.ColorText(log.ColorCyan, git.Remote()))
+	default:
+		log.Print(log.Release, "  Tag      %s", log.ColorText(log.ColorCyan, tag))
+		log.Print(log.Release, "  Remote   %s, branch %s",
+			log.ColorText(log.ColorCyan, git.Remote()),
+			log.ColorText(log.ColorCyan, git.CurrentBranch()))
+	}
+
+	printCommits()
+	if strategy.phase == phaseFinalize {
+		log.Print(log.Release, "  Files    none, the release commit was merged with the pull request")
+		return
+	}
+	printFiles(cfg, releaser)
+}
+
+func printCommits() {
+	to := "HEAD"
+	if strategy.target != "" {
+		to = strategy.target
+	}
+
+	previous := previousTag()
+	commits, err := git.CommitsBetween(previous, to)
+	if err != nil {
+		errors.Warning("Commits unknown", fmt.Sprintf("Could not list the commits of the release: %s", err.Error()))
+		return
+	}
+
+	since := "in the history"
+	if previous != "" {
+		since = "since " + previous
+	}
+	log.Print(log.Release, "  Commits  %d %s", len(commits), since)
+
+	for i, c := range commits {
+		if i == maxSummaryCommits {
+			log.Print(log.Release, "    ... and %d more", len(commits)-maxSummaryCommits)
+			break
+		}
+		log.Print(log.Release, "    %s %s", log.ColorText(log.ColorGreen, c.Hash), c.Subject)
+	}
+}
+
+func printFiles(cfg *config.NekoConfig, releaser Tool) {
+	files := []string{configFile}
+	complete := false
+
+	if l, ok := releaser.(releaseFileLister); ok {
+		files = append(files, l.ReleaseFiles()...)
+		complete = true
+	}
+
+	for _, pattern := range cfg.GitOptions().Files {
+		matches, _ := filepath.Glob(pattern)
+		files = append(files, matches...)
+	}
+
+	log.Print(log.Release, "  Files    %s", strings.Join(unique(files), ", "))
+	if !complete {
+		log.Print(log.Release, "           and the files changed by %s", releaser.Name())
+	}
+}
+
+func notApproved(reason string) {
+	errors.Fatal(
+		"Release not approved",
+		reason,
+		errors.ErrReleaseNotApproved,
+	)
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
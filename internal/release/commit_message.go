@@ -0,0 +1,115 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+const configFile = ".neko.json"
+
+// commitMessageData is exposed to the commit-message template
+type commitMessageData struct {
+	Version       string
+	Tag           string
+	ReleaseSystem string
+}
+
+// ParseCommitMessage validates a commit-message template
+func ParseCommitMessage(text string) (*template.Template, error) {
+	return template.New("commit-message").Option("missingkey=error").Parse(text)
+}
+
+// CommitMessage renders the release commit message. Tools committing
+// themselves pass it on so every release system uses the same format.
+// An overridden release freeze adds its justification as trailer.
+func (tb *ToolBase) CommitMessage(v *semver.Version) string {
+	cfg := tb.Config()
+
+	tpl, err := ParseCommitMessage(cfg.GitOptions().CommitMessage)
+	if err != nil {
+		errors.Fatal(
+			"Invalid commit message",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	data := commitMessageData{
+		Version:       v.String(),
+		Tag:           fmt.Sprintf("v%s", v),
+		ReleaseSystem: string(cfg.ReleaseSystem),
+	}
+
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, data); err != nil {
+		errors.Fatal(
+			"Invalid commit message",
+			fmt.Sprintf("Rendering commit-message failed: %s", err.Error()),
+			errors.ErrConfigMarshal,
+		)
+	}
+	return withFreezeTrailer(strings.TrimSpace(out.String()))
+}
+
+// GitEnv returns the environment for git commands creating release commits
+// and tags, it sets author and committer when a bot identity is configured
+func (tb *ToolBase) GitEnv() []string {
+	env := os.Environ()
+
+	author := tb.Config().GitOptions().Author
+	if author == nil {
+		return env
+	}
+
+	return append(env,
+		"GIT_AUTHOR_NAME="+author.Name,
+		"GIT_AUTHOR_EMAIL="+author.Email,
+		"GIT_COMMITTER_NAME="+author.Name,
+		"GIT_COMMITTER_EMAIL="+author.Email,
+	)
+}
+
+// stageFiles stages the configured release files and .neko.json
+func (tb *ToolBase) stageFiles(patterns []string) {
+	paths := []string{configFile}
+
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			errors.Fatal(
+				"Invalid release file pattern",
+				fmt.Sprintf("%s: %s", pattern, err.Error()),
+				errors.ErrConfigMarshal,
+			)
+		}
+
+		if len(matches) == 0 {
+			log.V(log.Release, fmt.Sprintf("No files match %s, skipping", pattern))
+			continue
+		}
+		paths = append(paths, matches...)
+	}
+
+	if err := git.Add(paths...); err != nil {
+		errors.Fatal(
+			"Failed to stage release files",
+			err.Error(),
+			errors.ErrReleaseCommit,
+		)
+	}
+}
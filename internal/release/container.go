@@ -0,0 +1,149 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// hasImages reports whether container images are published with the release
+func hasImages(cfg *config.NekoConfig) bool {
+	return cfg.Container != nil && len(cfg.Container.Images) > 0
+}
+
+// containerEngine returns the configured engine binary, docker by default
+func containerEngine(container *config.ContainerConfig) string {
+	if container.Engine == "" {
+		return string(config.EngineDocker)
+	}
+	return string(container.Engine)
+}
+
+// checkContainerEngine fails in the preflight when the engine of the
+// configured images is missing, before anything is released
+func checkContainerEngine(cfg *config.NekoConfig) {
+	if !hasImages(cfg) {
+		return
+	}
+	(&ToolBase{}).RequireBinary(containerEngine(cfg.Container))
+}
+
+// PublishImages builds and pushes the configured container images for the
+// released version. It runs after the release system, the version is
+// already tagged when it fails. The engine is checked by the preflight.
+func PublishImages(cfg *config.NekoConfig, v *semver.Version) {
+	if !hasImages(cfg) {
+		return
+	}
+	container := cfg.Container
+	engine := containerEngine(container)
+
+	tags := ImageTags(v, container.Tags)
+
+	for _, image := range container.Images {
+		ref := imageRef(cfg, container.Registry, image)
+		refs := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			refs = append(refs, fmt.Sprintf("%s:%s", ref, tag))
+		}
+
+		buildImage(engine, image, refs)
+		for _, r := range refs {
+			runEngine(engine, "Image push failed", "push", r)
+		}
+
+		log.Print(log.Release, "\uF00C Pushed image %s (%s)",
+			log.ColorText(log.ColorCyan, ref),
+			log.ColorText(log.ColorGreen, strings.Join(tags, ", ")))
+	}
+}
+
+// ImageTags applies the tag rules to v. Pre-releases only get the full
+// version so X.Y, X and latest always point to stable releases.
+func ImageTags(v *semver.Version, rules []string) []string {
+	if len(rules) == 0 {
+		rules = config.TagRules
+	}
+
+	if v.Prerelease() != "" {
+		return []string{v.String()}
+	}
+
+	var tags []string
+	for _, rule := range rules {
+		switch rule {
+		case config.TagRuleVersion:
+			tags = append(tags, v.String())
+		case config.TagRuleMinor:
+			tags = append(tags, fmt.Sprintf("%d.%d", v.Major(), v.Minor()))
+		case config.TagRuleMajor:
+			tags = append(tags, fmt.Sprintf("%d", v.Major()))
+		case config.TagRuleLatest:
+			tags = append(tags, "latest")
+		}
+	}
+
+	if len(tags) == 0 {
+		tags = []string{v.String()}
+	}
+	return tags
+}
+
+func imageRef(cfg *config.NekoConfig, registry string, image config.Image) string {
+	name := image.Name
+	if name == "" {
+		name = strings.ToLower(cfg.ProjectName)
+	}
+
+	if registry == "" {
+		return name
+	}
+	return strings.TrimSuffix(registry, "/") + "/" + name
+}
+
+func buildImage(engine string, image config.Image, refs []string) {
+	dockerfile := image.Dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+
+	context := image.Context
+	if context == "" {
+		context = "."
+	}
+
+	args := []string{"build", "-f", dockerfile}
+	for _, r := range refs {
+		args = append(args, "-t", r)
+	}
+	args = append(args, context)
+
+	runEngine(engine, "Image build failed", args...)
+}
+
+func runEngine(engine, title string, args ...string) {
+	log.V(log.Release, fmt.Sprintf("Running %s: %s",
+		engine, log.ColorText(log.ColorGreen, engine+" "+strings.Join(args, " "))))
+
+	cmd := exec.Command(engine, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			title,
+			fmt.Sprintf("The release is published, but %s %s failed: %s",
+				engine, strings.Join(args, " "), strings.TrimSpace(string(output))),
+			errors.ErrContainerExecution,
+		)
+	}
+}
@@ -0,0 +1,111 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/cron"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// freezeTrailer records the justification of an overridden freeze in the release commit
+const freezeTrailer = "Freeze-Override"
+
+// freezeLookahead bounds the search for the end of a recurring window
+const freezeLookahead = 8 * 24 * time.Hour
+
+// FreezeOverride is the justification given with --override-freeze
+var FreezeOverride string
+
+// freezeJustification is set once a freeze was overridden, CommitMessage
+// appends it as trailer
+var freezeJustification string
+
+// checkFreeze blocks the release inside a freeze window of .neko.json unless
+// it is overridden with a justification
+func checkFreeze(cfg *config.NekoConfig) {
+	if cfg.Freeze == nil {
+		return
+	}
+
+	reason, active := activeFreeze(cfg.Freeze, time.Now())
+	if !active {
+		log.V(log.Preflight, "No release freeze active")
+		return
+	}
+
+	justification := strings.Join(strings.Fields(FreezeOverride), " ")
+	if justification == "" {
+		errors.Error(
+			"Release Freeze",
+			fmt.Sprintf("Releases are frozen: %s.\nOverride the freeze with --override-freeze \"<justification>\".", reason),
+			errors.ErrReleaseFrozen,
+		)
+		return
+	}
+
+	errors.PrintError(errors.CLIError{
+		Level:   errors.ErrorLevelWarning,
+		Title:   "Release Freeze Overridden",
+		Message: fmt.Sprintf("Releases are frozen: %s.\nJustification: %s", reason, justification),
+		Code:    errors.ErrReleaseFrozen,
+	})
+	freezeJustification = justification
+}
+
+// activeFreeze returns the reason of the first window or range containing now
+func activeFreeze(freeze *config.FreezeConfig, now time.Time) (string, bool) {
+	loc, err := freeze.Location()
+	if err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+	now = now.In(loc)
+
+	for _, r := range freeze.Ranges {
+		from, to, err := r.Bounds(loc)
+		if err != nil || now.Before(from) || !now.Before(to) {
+			continue
+		}
+		return describeFreeze(r.Reason, fmt.Sprintf("freeze %s to %s", r.From, r.To), to), true
+	}
+
+	for _, w := range freeze.Windows {
+		schedule, err := cron.Parse(w.Cron)
+		if err != nil || !schedule.Matches(now) {
+			continue
+		}
+		return describeFreeze(w.Reason, fmt.Sprintf("freeze window %q", w.Cron), schedule.End(now, freezeLookahead)), true
+	}
+	return "", false
+}
+
+func describeFreeze(reason, fallback string, until time.Time) string {
+	if reason == "" {
+		reason = fallback
+	}
+	if until.IsZero() {
+		return reason
+	}
+	return fmt.Sprintf("%s (until %s)", reason, until.Format("Mon 2006-01-02 15:04 MST"))
+}
+
+// withFreezeTrailer appends the justification of an overridden freeze to message
+func withFreezeTrailer(message string) string {
+	if freezeJustification == "" {
+		return message
+	}
+	return fmt.Sprintf("%s\n\n%s: %s", message, freezeTrailer, freezeJustification)
+}
@@ -0,0 +1,253 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// PluginProtocol is the version of the JSON-over-stdio plugin protocol. Every
+// call starts the plugin once, writes a request to its stdin and reads a
+// single response from its stdout. Stderr is passed through to the user.
+const PluginProtocol = 1
+
+type pluginRequest struct {
+	Protocol int                `json:"protocol"`
+	Method   string             `json:"method"`
+	Version  string             `json:"version,omitempty"`
+	Config   *config.NekoConfig `json:"config"`
+}
+
+type pluginResponse struct {
+	Name string `json:"name,omitempty"`
+	// Type is the answer to survey, empty falls back to the neko survey
+	Type string `json:"type,omitempty"`
+	// Git asks neko to create and push the release commit and tag after
+	// release, the release method then only prepares files and must not publish
+	Git bool `json:"git,omitempty"`
+	// Publish asks neko to call publish once the commit and tag are pushed
+	Publish bool   `json:"publish,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Plugin is a release system provided by an external executable
+type Plugin struct {
+	ToolBase
+	name string
+	path string
+}
+
+// RegisterPlugins registers the plugins found on PATH and declared in cfg.
+// Plugins never replace built-in release systems.
+func RegisterPlugins(cfg *config.NekoConfig) {
+	for _, name := range pathPlugins() {
+		registerPlugin(cfg, name)
+	}
+
+	for name := range cfg.Plugins {
+		registerPlugin(cfg, name)
+	}
+}
+
+func registerPlugin(cfg *config.NekoConfig, name string) {
+	if t, ok := tools[name]; ok {
+		if _, plugin := t.(*Plugin); !plugin {
+			errors.Warning(
+				"Plugin ignored",
+				fmt.Sprintf("Plugin %s has the name of a built-in release system", name),
+			)
+			return
+		}
+	}
+
+	path, ok := cfg.PluginPath(name)
+	if !ok {
+		return
+	}
+
+	// resolve once, bare names are looked up on PATH like exec.Command does
+	resolved, err := exec.LookPath(path)
+	if err != nil {
+		errors.Warning(
+			"Plugin not found",
+			fmt.Sprintf("Plugin %s declared in .neko.json is not an executable: %s", name, path),
+		)
+		return
+	}
+	path = resolved
+
+	log.V(log.Config, fmt.Sprintf("Registered plugin %s: %s", name, log.ColorText(log.ColorGreen, path)))
+	tools[name] = &Plugin{name: name, path: path}
+}
+
+// pathPlugins returns the names of all neko-tool-* executables on PATH
+func pathPlugins() []string {
+	var names []string
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasPrefix(name, config.PluginPrefix) {
+				continue
+			}
+
+			if runtime.GOOS == "windows" {
+				name = strings.TrimSuffix(name, ".exe")
+			} else if info, err := entry.Info(); err != nil || info.Mode()&0111 == 0 {
+				continue
+			}
+
+			names = append(names, strings.TrimPrefix(name, config.PluginPrefix))
+		}
+	}
+	return names
+}
+
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+func (p *Plugin) Init(_ *config.NekoConfig) error {
+	resp, err := p.call("name", nil)
+	if err != nil {
+		return err
+	}
+	if resp.Name != p.name {
+		errors.Warning(
+			"Plugin name mismatch",
+			fmt.Sprintf("%s reports the name %q, it is registered as %q", p.path, resp.Name, p.name),
+		)
+	}
+
+	if _, err := p.call("init", nil); err != nil {
+		return err
+	}
+
+	log.Print(log.Init, "\uF00C Initialization complete for plugin %s", log.ColorText(log.ColorCyan, p.name))
+	return nil
+}
+
+func (p *Plugin) Release(v *semver.Version) error {
+	resp, err := p.call("release", v)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Git {
+		return nil
+	}
+
+	if err := p.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := p.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if err := p.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := p.PushGitTag(v); err != nil {
+		return err
+	}
+
+	if !resp.Publish {
+		return nil
+	}
+
+	_, err = p.call("publish", v)
+	return err
+}
+
+// SupportsPullRequest is false, plugins without git publish in their release
+// method and the publish call expects the tag on the base branch
+func (p *Plugin) SupportsPullRequest() bool {
+	return false
+}
+
+func (p *Plugin) Survey(v *semver.Version) (Type, error) {
+	resp, err := p.call("survey", v)
+	if err != nil {
+		return Patch, err
+	}
+
+	if resp.Type == "" {
+		return NekoSurvey(v)
+	}
+	return ParseReleaseType(resp.Type)
+}
+
+func (p *Plugin) SupportsSurvey() bool {
+	return true
+}
+
+// call runs one protocol method. Protocol violations are fatal, errors
+// reported by the plugin are returned.
+func (p *Plugin) call(method string, v *semver.Version) (*pluginResponse, error) {
+	req := pluginRequest{Protocol: PluginProtocol, Method: method, Config: p.Config()}
+	if v != nil {
+		req.Version = v.String()
+	}
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		errors.Fatal(
+			"Plugin request failed",
+			err.Error(),
+			errors.ErrPluginExecution,
+		)
+	}
+
+	log.V(log.Release, fmt.Sprintf("Calling plugin %s: %s", p.name, log.ColorText(log.ColorGreen, method)))
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(p.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), fmt.Sprintf("NEKO_PLUGIN_PROTOCOL=%d", PluginProtocol))
+
+	runErr := cmd.Run()
+
+	var resp pluginResponse
+	if err := json.Unmarshal(bytes.TrimSpace(stdout.Bytes()), &resp); err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("plugin %s failed on %s: %w", p.name, method, runErr)
+		}
+		errors.Fatal(
+			"Invalid plugin response",
+			fmt.Sprintf("Plugin %s returned no valid JSON for %s: %s", p.name, method, err.Error()),
+			errors.ErrPluginExecution,
+		)
+	}
+
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s failed on %s: %s", p.name, method, resp.Error)
+	}
+	if runErr != nil {
+		return nil, fmt.Errorf("plugin %s failed on %s: %w", p.name, method, runErr)
+	}
+	return &resp, nil
+}
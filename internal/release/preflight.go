@@ -7,13 +7,27 @@ package release
 */
 
 import (
+	"fmt"
+
+	"github.com/nekoman-hq/neko-cli/internal/ci"
+	"github.com/nekoman-hq/neko-cli/internal/config"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
 	"github.com/nekoman-hq/neko-cli/internal/git"
 	"github.com/nekoman-hq/neko-cli/internal/log"
 )
 
-func Preflight() {
+func Preflight(cfg *config.NekoConfig) {
 	log.V(log.Preflight, "Running pre-flight checks")
+
+	checkGitOptions(cfg)
+	checkFreeze(cfg)
+	checkContainerEngine(cfg)
+
+	if cfg.ActiveProfile() == config.ProfileCI {
+		ciPreflight()
+		return
+	}
+
 	if err := git.IsClean(); err != nil {
 		errors.Error(
 			"Uncommitted Changes",
@@ -46,13 +60,222 @@ func Preflight() {
 		)
 	}
 
-	if err := git.IsUpToDate(); err != nil {
+	checkShallow()
+	checkUpstream()
+
+	log.V(log.Preflight, "\uF00C Preflight checks succeeded!")
+}
+
+// checkShallow fails on shallow clones before the upstream comparison, their
+// cut history reports shared commits as diverged. Local runs never fetch,
+// the history is completed by the user.
+func checkShallow() {
+	shallow, err := git.IsShallow()
+	if err != nil {
+		errors.Warning("Clone depth unknown", err.Error())
+		return
+	}
+
+	if shallow {
 		errors.Error(
-			"Branch Out of Date",
+			"Shallow Clone",
+			fmt.Sprintf("The history of this clone is incomplete, the comparison with %s would be wrong.\nFetch the full history with: git fetch --unshallow %s", git.Remote(), git.Remote()),
+			errors.ErrShallowClone,
+		)
+	}
+}
+
+// checkUpstream compares the branch with the release remote. Behind and
+// diverged branches fail, unpushed commits are released with the release
+// commit and only reported.
+func checkUpstream() {
+	d, err := git.CompareUpstream()
+	if err != nil {
+		errors.Error(
+			"Upstream Comparison Failed",
 			err.Error(),
+			errors.ErrNoUpstream,
+		)
+		return
+	}
+
+	switch {
+	case d.Diverged():
+		errors.Error(
+			"Branch Diverged",
+			fmt.Sprintf("Your branch and %s have diverged, %d local and %d remote commit(s) differ.\nRebase onto or merge %s first.",
+				d.Upstream, d.Ahead, d.Behind, d.Upstream),
+			errors.ErrBranchDiverged,
+		)
+	case d.Behind > 0:
+		errors.Error(
+			"Branch Out of Date",
+			fmt.Sprintf("Your branch is %d commit(s) behind %s. Please pull the latest changes.", d.Behind, d.Upstream),
 			errors.ErrBranchBehind,
 		)
+	case d.Ahead > 0:
+		errors.PrintError(errors.CLIError{
+			Level:   errors.ErrorLevelWarning,
+			Title:   "Unpushed Commits",
+			Message: fmt.Sprintf("Your branch is %d commit(s) ahead of %s, they are pushed together with the release.", d.Ahead, d.Upstream),
+			Code:    errors.ErrBranchAhead,
+		})
+	default:
+		log.V(log.Preflight, "Branch is up to date with upstream")
+	}
+}
+
+// ciPreflight runs the checks of the ci profile. CI checkouts are usually
+// shallow, detached and without upstream, so the branch is taken from the
+// CI environment and the upstream checks are skipped.
+func ciPreflight() {
+	env := ci.Detect()
+	provider := "unknown"
+	if env != nil {
+		provider = env.Provider
+	}
+
+	log.Print(log.Preflight, "Running in CI profile (provider: %s)",
+		log.ColorText(log.ColorCyan, provider))
+
+	if err := git.IsClean(); err != nil {
+		errors.Error(
+			"Uncommitted Changes",
+			err.Error(),
+			errors.ErrDirtyWorkingTree,
+		)
+	}
+
+	branch := ciBranch(env)
+	if branch == "" {
+		errors.Error(
+			"Detached HEAD",
+			"HEAD is detached and the branch could not be derived from the CI environment.\nCheckout a branch or set CI_BRANCH.",
+			errors.ErrDetachedHead,
+		)
+	}
+
+	if err := git.CheckMainBranch(branch); err != nil {
+		errors.Error(
+			"Incorrect Branch",
+			err.Error(),
+			errors.ErrWrongBranch,
+		)
 	}
 
+	log.V(log.Preflight, "Skipping upstream checks in CI profile")
+
+	ensureFullHistory()
+
 	log.V(log.Preflight, "\uF00C Preflight checks succeeded!")
 }
+
+// ciBranch returns the checked out branch, falling back to the branch
+// reported by the CI provider when HEAD is detached
+func ciBranch(env *ci.Environment) string {
+	if !git.IsDetached() {
+		return git.CurrentBranch()
+	}
+
+	if env == nil || env.Branch == "" {
+		return ""
+	}
+
+	log.V(log.Preflight, fmt.Sprintf("HEAD is detached, using CI branch %s",
+		log.ColorText(log.ColorGreen, env.Branch)))
+	return env.Branch
+}
+
+// checkGitOptions validates the message templates and author and, for signed
+// releases, that a signing key is usable before anything is committed
+func checkGitOptions(cfg *config.NekoConfig) {
+	opts := cfg.GitOptions()
+
+	if opts.Annotated() {
+		if _, err := ParseTagMessage(opts.TagMessage); err != nil {
+			errors.Error(
+				"Invalid tag message",
+				err.Error(),
+				errors.ErrConfigMarshal,
+			)
+		}
+	}
+
+	if _, err := ParseCommitMessage(opts.CommitMessage); err != nil {
+		errors.Error(
+			"Invalid commit message",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	if opts.Author != nil && (opts.Author.Name == "" || opts.Author.Email == "") {
+		errors.Error(
+			"Invalid commit author",
+			"git.author in .neko.json needs both name and email",
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	if !opts.Sign {
+		return
+	}
+
+	if err := git.CheckSigningKey(opts.SigningKey); err != nil {
+		errors.Error(
+			"Signing key unavailable",
+			err.Error(),
+			errors.ErrSigningKey,
+		)
+	}
+
+	log.V(log.Preflight, fmt.Sprintf("Signing with %s key", git.SigningFormat()))
+}
+
+// deepenSteps are the depths a shallow CI clone is deepened by, one after
+// the other, until the last version tag is reachable. git fetch --deepen is
+// additive, so the clone ends up deeper by their sum.
+var deepenSteps = []int{50, 200, 1000}
+
+// ensureFullHistory deepens a shallow CI clone until the last version tag is
+// reachable, so the version guard and the changelog see the commits since
+// the last release. Without a tag the clone is unshallowed.
+func ensureFullHistory() {
+	shallow, err := git.IsShallow()
+	if err != nil {
+		errors.Warning("Clone depth unknown", err.Error())
+		return
+	}
+
+	if !shallow {
+		return
+	}
+
+	log.Print(log.Preflight, "Shallow clone detected, fetching history up to the last tag")
+	deepened := 0
+	for _, depth := range deepenSteps {
+		if err := git.Deepen(depth); err != nil {
+			errors.Warning("Deepening failed", err.Error())
+			break
+		}
+		deepened += depth
+
+		if shallow, err = git.IsShallow(); err == nil && !shallow {
+			return
+		}
+
+		if git.HasVersionTag() {
+			log.V(log.Preflight, fmt.Sprintf("Last tag reachable after deepening by %d commits", deepened))
+			return
+		}
+	}
+
+	log.Print(log.Preflight, "No tag reachable, fetching full history")
+	if err := git.Unshallow(); err != nil {
+		errors.Error(
+			"Shallow Clone",
+			err.Error(),
+			errors.ErrShallowClone,
+		)
+	}
+}
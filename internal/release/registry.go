@@ -6,7 +6,10 @@ package release
 @Since      18.12.2025
 */
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 var tools = make(map[string]Tool)
 
@@ -20,3 +23,13 @@ func Get(name string) (Tool, error) {
 	}
 	return nil, fmt.Errorf("unknown release system: %s", name)
 }
+
+// Names returns the names of all registered release systems in alphabetical order
+func Names() []string {
+	names := make([]string, 0, len(tools))
+	for name := range tools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
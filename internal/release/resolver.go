@@ -10,10 +10,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/Masterminds/semver/v3"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
 	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/prompt"
 )
 
 type Type string
@@ -65,14 +65,19 @@ func NekoSurvey(version *semver.Version) (Type, error) {
 		fmt.Sprintf("Major \uF178 %s", NextVersion(version, Major)),
 	}
 
-	var choice string
-	prompt := &survey.Select{
+	question := prompt.Question{
 		Message: "Which type of release do you want to create?",
 		Options: options,
 		Default: options[0], // Patch
+		Env:     "NEKO_RELEASE_TYPE",
 	}
 
-	if err := survey.AskOne(prompt, &choice); err != nil {
+	if answer, ok := prompt.Answer(question); ok {
+		return ParseReleaseType(answer)
+	}
+
+	choice, err := prompt.Select(question)
+	if err != nil {
 		return Patch, err
 	}
 
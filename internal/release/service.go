@@ -14,6 +14,7 @@ import (
 	"github.com/nekoman-hq/neko-cli/internal/config"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
 	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/hooks"
 	"github.com/nekoman-hq/neko-cli/internal/log"
 )
 
@@ -21,23 +22,21 @@ type Service struct {
 	cfg *config.NekoConfig
 }
 
+// hookRunner runs the hooks of the current release, tools reach it through ToolBase.RunHook
+var hookRunner *hooks.Runner
+
 func NewReleaseService(cfg *config.NekoConfig) *Service {
 	return &Service{cfg: cfg}
 }
 
 func (rs *Service) Run(args []string) error {
-	_, _ = git.Current()
-
-	Preflight()
-	version := VersionGuard(rs.cfg)
+	version := rs.prepare()
+	releaser := rs.releaser()
 
-	releaser, err := Get(string(rs.cfg.ReleaseSystem))
-	if err != nil {
-		errors.Fatal(
-			"Release System Not Found",
-			err.Error(),
-			errors.ErrInvalidReleaseSystem,
-		)
+	if rs.cfg.ReleaseStrategy == config.StrategyPullRequest {
+		requirePullRequestSupport(releaser)
+		strategy.phase = phasePrepare
+		strategy.base = baseBranch()
 	}
 
 	log.Print(log.Release,
@@ -59,9 +58,13 @@ func (rs *Service) Run(args []string) error {
 		)
 	}
 
+	newVersion := NextVersion(version, rt)
+	Approve(rs.cfg, releaser, version, &newVersion)
+	TagGuard(&newVersion, rt)
+
 	log.Print(log.VersionGuard, "\uF00C All checks have succeeded. %s", log.ColorText(log.ColorGreen, "Starting release now!"))
 
-	newVersion := NextVersion(version, rt)
+	hookRunner.SetVersion(newVersion.String())
 
 	if err := rs.updateConfig(&newVersion); err != nil {
 		errors.Warning(
@@ -69,7 +72,19 @@ func (rs *Service) Run(args []string) error {
 			fmt.Sprintf("Updating version in .neko.json failed. Attempting to proceed with release: %s", err.Error()))
 	}
 
-	if err := releaser.Release(&newVersion); err != nil {
+	hookRunner.Run(config.HookPostVersion)
+
+	err = releaser.Release(&newVersion)
+	if err == ErrAwaitingMerge {
+		log.Print(log.Release, "\uF00C Release %s is waiting for review, run %s after the merge",
+			log.ColorText(log.ColorCyan, newVersion.String()),
+			log.ColorText(log.ColorGreen, "neko release finalize"))
+		return nil
+	}
+	if err == nil && strategy.phase == phasePrepare {
+		err = fmt.Errorf("%s finished without opening the release pull request", releaser.Name())
+	}
+	if err != nil {
 		errors.Fatal(
 			"Release failed",
 			err.Error(),
@@ -80,10 +95,107 @@ func (rs *Service) Run(args []string) error {
 	log.Print(log.Release, "\uF00C Successfully released version %s",
 		log.ColorText(log.ColorCyan, newVersion.String()))
 
+	PublishImages(rs.cfg, &newVersion)
+	hookRunner.Run(config.HookPostRelease)
+
+	return nil
+}
+
+// Finalize completes a release of the pull-request strategy. The merge commit
+// of the release pull request is tagged and the publishing steps run on it.
+func (rs *Service) Finalize() error {
+	if rs.cfg.ReleaseStrategy != config.StrategyPullRequest {
+		errors.Fatal(
+			"Nothing to finalize",
+			fmt.Sprintf("release-strategy is not %q, neko release publishes directly", config.StrategyPullRequest),
+			errors.ErrReleaseFailed,
+		)
+	}
+
+	version := rs.prepare()
+	tag := fmt.Sprintf("v%s", version)
+	if git.TagExists(tag) {
+		errors.Fatal(
+			"Release already finalized",
+			fmt.Sprintf("Tag %s already exists, merge a new release pull request first", tag),
+			errors.ErrVersionViolation,
+		)
+	}
+
+	if freezeJustification != "" && !rs.cfg.GitOptions().Annotated() {
+		errors.Fatal(
+			"Freeze override not recorded",
+			"Finalize records the justification of --override-freeze in the tag message, lightweight tags have none.\nEnable git.annotated-tags in .neko.json or finalize after the freeze.",
+			errors.ErrReleaseFrozen,
+		)
+	}
+
+	releaser := rs.releaser()
+	requirePullRequestSupport(releaser)
+
+	hookRunner.SetVersion(version.String())
+	strategy.phase = phaseFinalize
+	strategy.target = mergeCommit(version)
+	Approve(rs.cfg, releaser, previousVersion(), version)
+
+	if err := releaser.Release(version); err != nil {
+		errors.Fatal(
+			"Release failed",
+			err.Error(),
+			errors.ErrReleaseFailed,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Successfully released version %s",
+		log.ColorText(log.ColorCyan, version.String()))
+
+	PublishImages(rs.cfg, version)
+	hookRunner.Run(config.HookPostRelease)
+
 	return nil
 }
 
+// prepare sets up hooks and runs the checks shared by Run and Finalize
+func (rs *Service) prepare() *semver.Version {
+	_, _ = git.Current()
+
+	hookRunner = hooks.NewRunner(rs.cfg)
+	errors.OnExit(hookRunner.RunOnFailure)
+	hookRunner.Run(config.HookPrePreflight)
+
+	Preflight(rs.cfg)
+	return VersionGuard(rs.cfg)
+}
+
+// releaser returns the configured release system
+func (rs *Service) releaser() Tool {
+	RegisterPlugins(rs.cfg)
+	releaser, err := Get(string(rs.cfg.ReleaseSystem))
+	if err != nil {
+		errors.Fatal(
+			"Release System Not Found",
+			err.Error(),
+			errors.ErrInvalidReleaseSystem,
+		)
+	}
+
+	releaser.Configure(rs.cfg)
+	return releaser
+}
+
 func (rs *Service) updateConfig(newVersion *semver.Version) error {
 	rs.cfg.Version = newVersion.String()
 	return config.SaveConfig(*rs.cfg)
 }
+
+// requirePullRequestSupport fails for release systems that commit or tag
+// on their own and cannot release through a pull request
+func requirePullRequestSupport(releaser Tool) {
+	if s, ok := releaser.(pullRequestSupporter); ok && !s.SupportsPullRequest() {
+		errors.Fatal(
+			"Release strategy not supported",
+			fmt.Sprintf("%s cannot release through a pull request, use release-strategy %q", releaser.Name(), config.StrategyDirect),
+			errors.ErrInvalidReleaseSystem,
+		)
+	}
+}
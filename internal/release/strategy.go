@@ -0,0 +1,177 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/changelog"
+	"github.com/nekoman-hq/neko-cli/internal/ci"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/git/github"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// phase is the part of the release the ToolBase helpers run in. With the
+// pull-request strategy a release is split into prepare and finalize.
+type phase int
+
+const (
+	phaseDirect phase = iota
+	// phasePrepare commits to a release branch, opens a pull request and
+	// stops the tool before anything is tagged or published
+	phasePrepare
+	// phaseFinalize tags the merge commit and runs the publishing steps,
+	// nothing is committed or pushed to the main branch
+	phaseFinalize
+)
+
+// ErrAwaitingMerge is returned by PushCommits after the release pull request
+// was opened, tools pass it up and the service ends the release there
+var ErrAwaitingMerge = fmt.Errorf("release pull request awaiting merge")
+
+// pullRequestSupporter is implemented by tools that cannot split their release,
+// e.g. because they commit and push on their own
+type pullRequestSupporter interface {
+	SupportsPullRequest() bool
+}
+
+var strategy = struct {
+	phase phase
+	// base is the branch the pull request targets
+	base string
+	// branch, version and subject of the release commit in the prepare phase
+	branch  string
+	version *semver.Version
+	title   string
+	// target is the merge commit tagged in the finalize phase
+	target string
+}{}
+
+// ReleaseBranch returns the branch used for the release pull request of v
+func ReleaseBranch(v *semver.Version) string {
+	return fmt.Sprintf("release/v%s", v)
+}
+
+// Finalizing reports whether the tool runs in neko release finalize
+func Finalizing() bool {
+	return strategy.phase == phaseFinalize
+}
+
+// baseBranch returns the branch the release pull request targets. A detached
+// HEAD in CI targets the branch reported by the CI environment.
+func baseBranch() string {
+	if !git.IsDetached() {
+		return git.CurrentBranch()
+	}
+
+	if env := ci.Detect(); env != nil && env.Branch != "" {
+		return env.Branch
+	}
+
+	errors.Fatal(
+		"Unknown base branch",
+		"HEAD is detached and no CI branch was found, the release pull request needs a base branch",
+		errors.ErrDetachedHead,
+	)
+	return ""
+}
+
+// openPullRequest opens the pull request of the pushed release branch and
+// switches back to where the release started. Commits starting with ignore
+// are left out of the pull request body.
+func openPullRequest(ignore string) {
+	repoInfo, _ := git.Current()
+
+	notes, err := changelog.Generate(fmt.Sprintf("v%s", strategy.version), previousTag(), "HEAD", ignore)
+	if err != nil {
+		errors.Warning("Changelog generation failed", err.Error())
+	}
+
+	pr, err := git.CreatePullRequest(repoInfo, github.PullRequestRequest{
+		Title: strategy.title,
+		Head:  strategy.branch,
+		Base:  strategy.base,
+		Body:  pullRequestBody(notes),
+	})
+	if err != nil {
+		errors.Fatal(
+			"Failed to open pull request",
+			err.Error(),
+			errors.ErrPullRequest,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Opened pull request #%d: %s",
+		pr.Number, log.ColorText(log.ColorCyan, pr.HTMLURL))
+
+	if err := git.Checkout("-"); err != nil {
+		errors.Warning("Could not switch back to the base branch", err.Error())
+	}
+}
+
+// pullRequestBody lists the release notes and how to finish the release. An
+// overridden freeze ends the body as trailer, so squash merges keep it.
+func pullRequestBody(notes string) string {
+	body := notes + "\nMerge this pull request, then run `neko release finalize` on the updated base branch."
+	return withFreezeTrailer(strings.TrimSpace(body)) + "\n"
+}
+
+// mergeCommit returns the merge commit of the merged release pull request of v
+func mergeCommit(v *semver.Version) string {
+	repoInfo, _ := git.Current()
+	branch := ReleaseBranch(v)
+
+	pr, err := git.MergedPullRequest(repoInfo, branch)
+	if err != nil {
+		errors.Fatal(
+			"Failed to look up pull request",
+			err.Error(),
+			errors.ErrPullRequest,
+		)
+	}
+
+	if pr == nil {
+		errors.Fatal(
+			"Release not merged",
+			fmt.Sprintf("No merged pull request found for %s.\nMerge the release pull request first.", branch),
+			errors.ErrPullRequestNotMerged,
+		)
+	}
+
+	if !git.IsAncestor(pr.MergeCommitSHA) {
+		errors.Fatal(
+			"Merge commit missing",
+			fmt.Sprintf("Merge commit %s of #%d is not part of HEAD.\nPull the base branch first.", pr.MergeCommitSHA, pr.Number),
+			errors.ErrBranchBehind,
+		)
+	}
+
+	log.Print(log.Release, "Found merged pull request #%d (%s)",
+		pr.Number, log.ColorText(log.ColorGreen, pr.MergeCommitSHA))
+	return pr.MergeCommitSHA
+}
+
+// previousVersion returns the version of the previous tag, nil without one
+func previousVersion() *semver.Version {
+	v, err := semver.NewVersion(previousTag())
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+func previousTag() string {
+	previous := git.LatestTag()
+	if !git.TagExists(previous) {
+		return ""
+	}
+	return previous
+}
@@ -0,0 +1,127 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+)
+
+// maxTagCandidates bounds the search for the next free version
+const maxTagCandidates = 20
+
+// tagGuard looks for existing tags and releases of a version
+type tagGuard struct {
+	head   string
+	remote map[string]string
+	repo   *git.RepoInfo
+}
+
+// TagGuard fails before anything is changed when the tag of v already exists
+// locally, on the release remote or as a forge release. The error names every
+// collision and the next free version of the same release type.
+func TagGuard(v *semver.Version, rt Type) {
+	log.V(log.VersionGuard, fmt.Sprintf("Checking tag collisions of %s",
+		log.ColorText(log.ColorCyan, fmt.Sprintf("v%s", v))))
+
+	g := newTagGuard()
+	collisions := g.collisions(v, true)
+	if len(collisions) == 0 {
+		log.V(log.VersionGuard, "\uF00C Tag is free")
+		return
+	}
+
+	message := fmt.Sprintf("Tag v%s already exists:\n  - %s", v, strings.Join(collisions, "\n  - "))
+
+	next := *v
+	for range maxTagCandidates {
+		next = NextVersion(&next, rt)
+		if len(g.collisions(&next, false)) == 0 {
+			message += fmt.Sprintf("\n\nThe next free %s version is %s. Fetch the tags and set version in .neko.json to the latest released version.", rt, next.String())
+			break
+		}
+	}
+
+	errors.Fatal(
+		"Tag collision",
+		message,
+		errors.ErrTagCollision,
+	)
+}
+
+func newTagGuard() *tagGuard {
+	g := &tagGuard{}
+
+	head, err := git.ResolveCommit("HEAD")
+	if err == nil {
+		g.head = head
+	}
+
+	remote, err := git.RemoteTags()
+	if err != nil {
+		errors.Warning("Remote tags unknown", fmt.Sprintf("Skipping the remote tag check: %s", err.Error()))
+	}
+	g.remote = remote
+
+	if config.HasPAT() {
+		g.repo, _ = git.Current()
+	} else {
+		log.V(log.VersionGuard, "GITHUB_TOKEN not set, skipping the release check")
+	}
+	return g
+}
+
+// collisions describes where the tag of v exists. The forge is only asked
+// for the requested version, not for every candidate.
+func (g *tagGuard) collisions(v *semver.Version, forge bool) []string {
+	tag := fmt.Sprintf("v%s", v)
+	var found []string
+
+	if git.TagExists(tag) {
+		found = append(found, "locally, "+g.describe(tag))
+	}
+
+	if sha, ok := g.remote[tag]; ok {
+		found = append(found, fmt.Sprintf("on remote %s at %s", git.Remote(), short(sha)))
+	}
+
+	if forge && g.repo != nil {
+		rel, err := git.ReleaseByTag(g.repo, tag)
+		if err != nil {
+			errors.Warning("Release lookup failed", fmt.Sprintf("Skipping the release check: %s", err.Error()))
+		} else if rel != nil {
+			found = append(found, fmt.Sprintf("as GitHub release %s", rel.HTMLURL))
+		}
+	}
+	return found
+}
+
+// describe tells where an existing local tag points relative to HEAD
+func (g *tagGuard) describe(tag string) string {
+	sha, err := git.ResolveCommit(tag)
+	if err != nil {
+		return "pointing at an unknown commit"
+	}
+
+	if sha == g.head {
+		return "pointing at HEAD, a previous release stopped after tagging"
+	}
+	return fmt.Sprintf("pointing at %s instead of HEAD", short(sha))
+}
+
+func short(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,92 @@
+package release
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/changelog"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+)
+
+// tagMessageData is exposed to the tag-message template
+type tagMessageData struct {
+	Tag         string
+	Version     string
+	PreviousTag string
+	Changelog   string
+}
+
+// newTagMessageData captures the previous tag before the new tag exists, so
+// component tags created afterwards share the same changelog
+func newTagMessageData(v *semver.Version) *tagMessageData {
+	previous := git.LatestTag()
+	if !git.TagExists(previous) {
+		previous = ""
+	}
+
+	return &tagMessageData{Version: v.String(), PreviousTag: previous}
+}
+
+// ParseTagMessage validates a tag-message template
+func ParseTagMessage(text string) (*template.Template, error) {
+	return template.New("tag-message").Option("missingkey=error").Parse(text)
+}
+
+// tagMessage renders the message of an annotated tag
+func (tb *ToolBase) tagMessage(tag, text string) string {
+	data := tagMessageData{Tag: tag}
+	if tb.message != nil {
+		data = *tb.message
+		data.Tag = tag
+	}
+
+	if strings.Contains(text, ".Changelog") {
+		to := "HEAD"
+		if strategy.target != "" {
+			to = strategy.target
+		}
+
+		notes, err := changelog.Generate(tag, data.PreviousTag, to, tb.Config().GitOptions().CommitPrefix())
+		if err != nil {
+			errors.Warning("Changelog generation failed", err.Error())
+		}
+		data.Changelog = notes
+	}
+
+	tpl, err := ParseTagMessage(text)
+	if err != nil {
+		errors.Fatal(
+			"Invalid tag message",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, data); err != nil {
+		errors.Fatal(
+			"Invalid tag message",
+			fmt.Sprintf("Rendering tag-message failed: %s", err.Error()),
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	message := strings.TrimSpace(out.String())
+	// finalize creates no release commit, its tag records an overridden freeze
+	if strategy.phase == phaseFinalize {
+		message = withFreezeTrailer(message)
+	}
+
+	// verbatim messages need the newline, otherwise the signature is glued to the last line
+	return message + "\n"
+}
@@ -6,8 +6,10 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/ci"
 	"github.com/nekoman-hq/neko-cli/internal/config"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
 	"github.com/nekoman-hq/neko-cli/internal/log"
 )
 
@@ -19,13 +21,40 @@ import (
 
 type Tool interface {
 	Name() string
+	Configure(cfg *config.NekoConfig)
 	Init(cfg *config.NekoConfig) error
 	Release(v *semver.Version) error
 	Survey(v *semver.Version) (Type, error)
 	SupportsSurvey() bool
 }
 
-type ToolBase struct{}
+type ToolBase struct {
+	cfg *config.NekoConfig
+	// message caches the tag message data of the current release
+	message *tagMessageData
+}
+
+// Configure hands the loaded configuration to the tool before a release
+func (tb *ToolBase) Configure(cfg *config.NekoConfig) {
+	tb.cfg = cfg
+}
+
+// Config returns the configuration of the current release. Tools used
+// without Configure get an empty configuration.
+func (tb *ToolBase) Config() *config.NekoConfig {
+	if tb.cfg == nil {
+		return &config.NekoConfig{}
+	}
+	return tb.cfg
+}
+
+// RunHook runs the configured hooks of stage. Tools creating the release
+// commit and tag themselves call it for pre-commit and post-tag.
+func (tb *ToolBase) RunHook(stage config.HookStage) {
+	if hookRunner != nil {
+		hookRunner.Run(stage)
+	}
+}
 
 func (tb *ToolBase) RequireBinary(name string) {
 	log.V(log.Init,
@@ -57,12 +86,45 @@ func (tb *ToolBase) RequireBinary(name string) {
 
 // CreateReleaseCommit creates the chore commit for the release
 func (tb *ToolBase) CreateReleaseCommit(v *semver.Version) error {
-	commitMsg := fmt.Sprintf("chore(neko-release): %s", v)
+	if strategy.phase == phaseFinalize {
+		log.V(log.Release, "Release commit was merged with the pull request, skipping")
+		return nil
+	}
+
+	tb.RunHook(config.HookPreCommit)
+
+	opts := tb.Config().GitOptions()
+	commitMsg := tb.CommitMessage(v)
+
+	args := []string{"commit", "--allow-empty", "-m", commitMsg}
+	if len(opts.Files) > 0 {
+		tb.stageFiles(opts.Files)
+	} else {
+		args = append(args, "-a")
+	}
+	if opts.Sign {
+		args = append(args, signFlag("--gpg-sign", opts.SigningKey))
+	}
+
+	if strategy.phase == phasePrepare {
+		strategy.branch = ReleaseBranch(v)
+		if err := git.CreateBranch(strategy.branch); err != nil {
+			errors.Fatal(
+				"Failed to create release branch",
+				err.Error(),
+				errors.ErrReleaseCommit,
+			)
+		}
+		// the pull request title is the subject, the freeze trailer goes into its body
+		subject, _, _ := strings.Cut(commitMsg, "\n")
+		strategy.version, strategy.title = v, subject
+	}
 
 	log.V(log.Release, fmt.Sprintf("Creating release commit: %s",
-		log.ColorText(log.ColorGreen, fmt.Sprintf("git commit --allow-empty -m \"%s\"", commitMsg))))
+		log.ColorText(log.ColorGreen, "git "+strings.Join(args, " "))))
 
-	cmd := exec.Command("git", "commit", "--allow-empty", "-a", "-m", commitMsg)
+	cmd := exec.Command("git", args...)
+	cmd.Env = tb.GitEnv()
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		errors.Fatal(
@@ -77,14 +139,80 @@ func (tb *ToolBase) CreateReleaseCommit(v *semver.Version) error {
 	return nil
 }
 
+// CreateCommit commits paths with message and the configured author and
+// signature. Unlike the release commit it runs no hooks and is not part of
+// the release strategy, e.g. for a development version bump after the release.
+func (tb *ToolBase) CreateCommit(message string, paths ...string) error {
+	opts := tb.Config().GitOptions()
+
+	args := []string{"commit", "-m", message}
+	if opts.Sign {
+		args = append(args, signFlag("--gpg-sign", opts.SigningKey))
+	}
+	args = append(append(args, "--"), paths...)
+
+	log.V(log.Release, fmt.Sprintf("Creating commit: %s",
+		log.ColorText(log.ColorGreen, "git "+strings.Join(args, " "))))
+
+	cmd := exec.Command("git", args...)
+	cmd.Env = tb.GitEnv()
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git commit failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	log.Print(log.Release, "\uF00C Created commit: %s", log.ColorText(log.ColorGreen, message))
+	return nil
+}
+
 // CreateGitTag creates a git tag for the version
 func (tb *ToolBase) CreateGitTag(v *semver.Version) error {
-	tag := fmt.Sprintf("v%s", v)
+	tb.message = newTagMessageData(v)
+
+	if err := tb.CreateTag(fmt.Sprintf("v%s", v)); err != nil {
+		return err
+	}
+
+	// the tag of a prepared release is created and hooked by finalize
+	if strategy.phase == phasePrepare {
+		return nil
+	}
+
+	tb.RunHook(config.HookPostTag)
+	return nil
+}
+
+// CreateTag creates a git tag with an arbitrary name, e.g. per-component tags
+func (tb *ToolBase) CreateTag(tag string) error {
+	if strategy.phase == phasePrepare {
+		log.V(log.Release, fmt.Sprintf("Tag %s is created by neko release finalize, skipping", tag))
+		return nil
+	}
+
+	opts := tb.Config().GitOptions()
+
+	args := []string{"tag"}
+	if opts.Annotated() {
+		if opts.Sign {
+			args = append(args, signFlag("--local-user", opts.SigningKey))
+		} else {
+			args = append(args, "--annotate")
+		}
+	}
 
 	log.V(log.Release, fmt.Sprintf("Creating git tag: %s",
-		log.ColorText(log.ColorGreen, fmt.Sprintf("git tag %s", tag))))
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git %s %s", strings.Join(args, " "), tag))))
+
+	if opts.Annotated() {
+		args = append(args, "--cleanup=verbatim", "-m", tb.tagMessage(tag, opts.TagMessage))
+	}
+	args = append(args, tag)
+	if strategy.target != "" {
+		args = append(args, strategy.target)
+	}
 
-	cmd := exec.Command("git", "tag", tag)
+	cmd := exec.Command("git", args...)
+	cmd.Env = tb.GitEnv()
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		errors.Fatal(
@@ -94,17 +222,57 @@ func (tb *ToolBase) CreateGitTag(v *semver.Version) error {
 		)
 	}
 
+	tb.VerifySignature(tag)
+
 	log.Print(log.Release, "\uF00C Created git tag: %s",
 		log.ColorText(log.ColorGreen, tag))
 	return nil
 }
 
+// SigningFlags returns the git commit and git tag flags of signed releases,
+// tools committing and tagging themselves pass them on. Both are empty
+// without git.sign.
+func (tb *ToolBase) SigningFlags() (string, string) {
+	opts := tb.Config().GitOptions()
+	if !opts.Sign {
+		return "", ""
+	}
+	return signFlag("--gpg-sign", opts.SigningKey), signFlag("--local-user", opts.SigningKey)
+}
+
+// VerifySignature checks the signature of tag with git tag -v when git.sign is set
+func (tb *ToolBase) VerifySignature(tag string) {
+	if !tb.Config().GitOptions().Sign {
+		return
+	}
+
+	if err := git.VerifyTag(tag); err != nil {
+		errors.Fatal(
+			"Tag signature invalid",
+			err.Error(),
+			errors.ErrTagSignature,
+		)
+	}
+	log.Print(log.Release, "\uF00C Verified signature of %s", log.ColorText(log.ColorGreen, tag))
+}
+
 // PushCommits pushes the release commit to remote
 func (tb *ToolBase) PushCommits() error {
+	switch strategy.phase {
+	case phaseFinalize:
+		log.V(log.Release, "Release commit was merged with the pull request, skipping push")
+		return nil
+	case phasePrepare:
+		return tb.pushReleaseBranch()
+	}
+
+	refspec := pushRefspec()
+	remote := git.Remote()
+
 	log.V(log.Release, fmt.Sprintf("Pushing release commit: %s",
-		log.ColorText(log.ColorGreen, "git push origin HEAD")))
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git push %s %s", remote, refspec))))
 
-	cmd := exec.Command("git", "push", "origin", "HEAD")
+	cmd := exec.Command("git", "push", remote, refspec)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		errors.Fatal(
@@ -115,18 +283,51 @@ func (tb *ToolBase) PushCommits() error {
 	}
 
 	log.Print(log.Release, "\uF00C Pushed release commit to %s",
-		log.ColorText(log.ColorGreen, "origin"))
+		log.ColorText(log.ColorGreen, remote))
 	return nil
 }
 
+// pushReleaseBranch pushes the release branch and opens its pull request
+func (tb *ToolBase) pushReleaseBranch() error {
+	refspec := fmt.Sprintf("HEAD:refs/heads/%s", strategy.branch)
+	remote := git.Remote()
+
+	log.V(log.Release, fmt.Sprintf("Pushing release branch: %s",
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git push %s %s", remote, refspec))))
+
+	output, err := exec.Command("git", "push", remote, refspec).CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"Failed to push release branch",
+			fmt.Sprintf("git push failed: %s", strings.TrimSpace(string(output))),
+			errors.ErrReleasePush,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Pushed release branch %s",
+		log.ColorText(log.ColorGreen, strategy.branch))
+
+	openPullRequest(tb.Config().GitOptions().CommitPrefix())
+	return ErrAwaitingMerge
+}
+
 // PushGitTag pushes the git tag to remote
 func (tb *ToolBase) PushGitTag(v *semver.Version) error {
-	tag := fmt.Sprintf("v%s", v)
+	return tb.PushTag(fmt.Sprintf("v%s", v))
+}
+
+// PushTag pushes a tag created with CreateTag to remote
+func (tb *ToolBase) PushTag(tag string) error {
+	if strategy.phase == phasePrepare {
+		return nil
+	}
+
+	remote := git.Remote()
 
 	log.V(log.Release, fmt.Sprintf("Pushing git tag: %s",
-		log.ColorText(log.ColorGreen, fmt.Sprintf("git push origin %s", tag))))
+		log.ColorText(log.ColorGreen, fmt.Sprintf("git push %s %s", remote, tag))))
 
-	cmd := exec.Command("git", "push", "origin", tag)
+	cmd := exec.Command("git", "push", remote, tag)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		errors.Fatal(
@@ -140,3 +341,28 @@ func (tb *ToolBase) PushGitTag(v *semver.Version) error {
 		log.ColorText(log.ColorGreen, tag))
 	return nil
 }
+
+// signFlag returns flag for the configured key, or the short form that lets
+// git pick the key from its own config
+func signFlag(flag, key string) string {
+	if key != "" {
+		return fmt.Sprintf("%s=%s", flag, key)
+	}
+	if flag == "--local-user" {
+		return "--sign"
+	}
+	return "--gpg-sign"
+}
+
+// pushRefspec returns the refspec for the release commit. A detached HEAD in
+// CI is pushed to the branch reported by the CI environment.
+func pushRefspec() string {
+	if !git.IsDetached() {
+		return "HEAD"
+	}
+
+	if env := ci.Detect(); env != nil && env.Branch != "" {
+		return fmt.Sprintf("HEAD:refs/heads/%s", env.Branch)
+	}
+	return "HEAD"
+}
@@ -0,0 +1,199 @@
+// Package cargo includes the cargo / crates release-system logic
+package cargo
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+// Options are read from tools.cargo in .neko.json
+type Options struct {
+	// Publish runs cargo publish after the tag is pushed
+	Publish bool `json:"publish,omitempty"`
+	// Registry is passed to cargo publish --registry, empty means crates.io
+	Registry string `json:"registry,omitempty"`
+}
+
+type Cargo struct {
+	release.ToolBase
+}
+
+func (c *Cargo) Name() string {
+	return "cargo"
+}
+
+func (c *Cargo) Init(_ *config.NekoConfig) error {
+	c.RequireBinary("cargo")
+
+	if _, err := os.Stat(rootManifest); os.IsNotExist(err) {
+		errors.Warning(
+			"Project not correctly initialized",
+			"No Cargo.toml found - this doesn't appear to be a Rust project",
+		)
+	}
+
+	log.Print(log.Init, "\uF00C Initialization complete for %s", log.ColorText(log.ColorCyan, c.Name()))
+	return nil
+}
+
+func (c *Cargo) Release(v *semver.Version) error {
+	opts := c.options()
+	c.RequireBinary("cargo")
+
+	ws, err := loadWorkspace()
+	if err != nil {
+		errors.Fatal(
+			"Failed to read Cargo.toml",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	c.bumpManifests(ws, v)
+	c.refreshLockfile()
+	c.runCargoPackage(ws)
+
+	if err := c.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := c.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if err := c.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := c.PushGitTag(v); err != nil {
+		return err
+	}
+
+	if opts.Publish {
+		c.runCargoPublish(ws, opts)
+	}
+
+	return nil
+}
+
+func (c *Cargo) Survey(v *semver.Version) (release.Type, error) {
+	return release.NekoSurvey(v)
+}
+
+func (c *Cargo) SupportsSurvey() bool {
+	return true
+}
+
+// ReleaseFiles returns the workspace manifests and Cargo.lock
+func (c *Cargo) ReleaseFiles() []string {
+	ws, err := loadWorkspace()
+	if err != nil {
+		return nil
+	}
+
+	files := append([]string{rootManifest}, ws.members...)
+	if _, err := os.Stat("Cargo.lock"); err == nil {
+		files = append(files, "Cargo.lock")
+	}
+	return files
+}
+
+func (c *Cargo) options() Options {
+	var opts Options
+	if err := c.Config().ToolOptions(c.Name(), &opts); err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+	return opts
+}
+
+func (c *Cargo) bumpManifests(ws *workspace, v *semver.Version) {
+	updated, declared, err := ws.setVersion(v.String())
+	if err != nil {
+		errors.Fatal(
+			"Failed to update Cargo.toml",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	if !declared {
+		errors.Fatal(
+			"No version declaration found",
+			"Neither [package] nor [workspace.package] declare a version in Cargo.toml",
+			errors.ErrFileAccess,
+		)
+	}
+
+	for _, path := range updated {
+		log.V(log.Release, fmt.Sprintf("Updated %s to %s", path, v))
+	}
+
+	log.Print(log.Release, "\uF00C Updated %d manifests to %s",
+		len(updated), log.ColorText(log.ColorGreen, v.String()))
+}
+
+// refreshLockfile writes the new workspace versions into Cargo.lock
+func (c *Cargo) refreshLockfile() {
+	if _, err := os.Stat("Cargo.lock"); os.IsNotExist(err) {
+		log.V(log.Release, "No Cargo.lock found, skipping lockfile refresh")
+		return
+	}
+
+	c.runCargo("Cargo.lock refresh failed", "update", "--workspace")
+	log.Print(log.Release, "\uF00C Refreshed %s", log.ColorText(log.ColorCyan, "Cargo.lock"))
+}
+
+// runCargoPackage is the dry run, it builds and verifies the crates that would be published
+func (c *Cargo) runCargoPackage(ws *workspace) {
+	args := append([]string{"package", "--allow-dirty"}, ws.packageFlags()...)
+
+	c.runCargo("cargo package failed", args...)
+	log.Print(log.Release, "\uF00C cargo package %s", log.ColorText(log.ColorGreen, "successful"))
+}
+
+func (c *Cargo) runCargoPublish(ws *workspace, opts Options) {
+	args := append([]string{"publish"}, ws.packageFlags()...)
+	if opts.Registry != "" {
+		args = append(args, "--registry", opts.Registry)
+	}
+
+	c.runCargo("cargo publish failed", args...)
+	log.Print(log.Release, "\uF00C cargo publish %s", log.ColorText(log.ColorGreen, "successful"))
+}
+
+func (c *Cargo) runCargo(title string, args ...string) {
+	log.V(log.Release, fmt.Sprintf("Running cargo: %s",
+		log.ColorText(log.ColorGreen, "cargo "+strings.Join(args, " "))))
+
+	cmd := exec.Command("cargo", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			title,
+			fmt.Sprintf("cargo %s failed: %s", strings.Join(args, " "), strings.TrimSpace(string(output))),
+			errors.ErrCargoExecution,
+		)
+	}
+}
+
+func init() {
+	release.Register(&Cargo{})
+}
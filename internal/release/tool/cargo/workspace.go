@@ -0,0 +1,202 @@
+package cargo
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/nekoman-hq/neko-cli/internal/manifest"
+)
+
+const rootManifest = "Cargo.toml"
+
+var (
+	headerRegex = regexp.MustCompile(`^\s*\[\s*([^\[\]]+?)\s*\]\s*(?:#.*)?$`)
+	// dependencyTableRegex matches [dependencies.foo] and its dev, build and target variants
+	dependencyTableRegex  = regexp.MustCompile(`(?:^|\.)(?:dev-|build-)?dependencies\."?([\w-]+)"?$`)
+	inlineDependencyRegex = regexp.MustCompile(`^\s*"?([\w-]+)"?\s*=\s*\{(.*)\}`)
+	pathKeyRegex          = regexp.MustCompile(`(?:^|[\s,{])path\s*=`)
+	packageKeyRegex       = regexp.MustCompile(`(?:^|[\s,{])package\s*=\s*"([^"]+)"`)
+	versionKeyRegex       = regexp.MustCompile(`((?:^|[\s,{])version\s*=\s*"[=^~<>]*\s*)([^"]*)(")`)
+)
+
+// workspace lists the manifests whose versions neko manages
+type workspace struct {
+	// virtual workspaces have no [package] in the root manifest
+	virtual bool
+	members []string
+	// crates are the package names of the root and the members, path
+	// dependencies on them get the new version requirement
+	crates map[string]bool
+}
+
+func loadWorkspace() (*workspace, error) {
+	data, err := os.ReadFile(rootManifest)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", rootManifest, err)
+	}
+	content := string(data)
+
+	name, hasPackage := manifest.TOMLValue(content, "package", "name")
+	ws := &workspace{virtual: !hasPackage, crates: map[string]bool{}}
+	if hasPackage {
+		ws.crates[name] = true
+	}
+
+	for _, pattern := range manifest.TOMLStrings(content, "workspace", "members") {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspace member %s: %w", pattern, err)
+		}
+
+		for _, dir := range matches {
+			member := filepath.Join(dir, rootManifest)
+			data, err := os.ReadFile(member)
+			if err != nil {
+				continue
+			}
+
+			ws.members = append(ws.members, member)
+			if name, ok := manifest.TOMLValue(string(data), "package", "name"); ok {
+				ws.crates[name] = true
+			}
+		}
+	}
+	return ws, nil
+}
+
+// setVersion updates [package] and [workspace.package] of the root manifest
+// and every member with its own version literal. Members inheriting the
+// version through version.workspace = true are updated with the root. The
+// version requirements of path dependencies on workspace crates follow.
+// It returns the changed manifests and whether any version was declared.
+func (ws *workspace) setVersion(version string) ([]string, bool, error) {
+	var updated []string
+	declared := false
+
+	for _, path := range append([]string{rootManifest}, ws.members...) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, false, fmt.Errorf("read %s: %w", path, err)
+		}
+
+		content, changed := string(data), false
+		for _, table := range []string{"package", "workspace.package"} {
+			if _, ok := manifest.TOMLValue(content, table, "version"); ok {
+				declared = true
+			}
+
+			var tableChanged bool
+			content, tableChanged = manifest.SetTOMLValue(content, table, "version", version)
+			changed = changed || tableChanged
+		}
+
+		var depsChanged bool
+		content, depsChanged = setDependencyVersions(content, ws.crates, version)
+		changed = changed || depsChanged
+
+		if !changed {
+			continue
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return nil, false, fmt.Errorf("write %s: %w", path, err)
+		}
+		updated = append(updated, path)
+	}
+	return updated, declared, nil
+}
+
+// packageFlags selects every crate of the workspace for cargo package and
+// publish, a root package without members is selected by default
+func (ws *workspace) packageFlags() []string {
+	if ws.virtual || len(ws.members) > 0 {
+		return []string{"--workspace"}
+	}
+	return nil
+}
+
+// setDependencyVersions updates the version requirement of path dependencies
+// on crates, both inline ({ path = "../foo", version = "1.2.3" }) and as
+// [dependencies.foo] table. Operators like = or ^ are kept.
+func setDependencyVersions(content string, crates map[string]bool, version string) (string, bool) {
+	lines := strings.Split(content, "\n")
+	changed := false
+
+	for start := 0; start < len(lines); {
+		m := headerRegex.FindStringSubmatch(lines[start])
+		end := start + 1
+		for end < len(lines) && !isHeader(lines[end]) {
+			end++
+		}
+		if m == nil {
+			start = end
+			continue
+		}
+
+		header, block := m[1], lines[start+1:end]
+		switch {
+		case isDependencyTable(header):
+			for i, line := range block {
+				dep := inlineDependencyRegex.FindStringSubmatch(line)
+				if dep == nil || !pathKeyRegex.MatchString(dep[2]) || !crates[crateName(dep[1], dep[2])] {
+					continue
+				}
+				if updated := versionKeyRegex.ReplaceAllString(line, "${1}"+version+"${3}"); updated != line {
+					block[i], changed = updated, true
+				}
+			}
+		case dependencyTableRegex.MatchString(header):
+			name := dependencyTableRegex.FindStringSubmatch(header)[1]
+			table := strings.Join(block, "\n")
+			if !pathKeyRegex.MatchString(table) || !crates[crateName(name, table)] {
+				break
+			}
+			for i, line := range block {
+				if !strings.HasPrefix(strings.TrimSpace(line), "version") {
+					continue
+				}
+				if updated := versionKeyRegex.ReplaceAllString(line, "${1}"+version+"${3}"); updated != line {
+					block[i], changed = updated, true
+				}
+			}
+		}
+		start = end
+	}
+
+	if !changed {
+		return content, false
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+// isHeader reports whether line starts a table or an array of tables
+func isHeader(line string) bool {
+	return headerRegex.MatchString(line) || strings.HasPrefix(strings.TrimSpace(line), "[[")
+}
+
+func isDependencyTable(header string) bool {
+	for _, kind := range []string{"dependencies", "dev-dependencies", "build-dependencies"} {
+		if header == kind || strings.HasSuffix(header, "."+kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// crateName returns the package a dependency refers to, renamed
+// dependencies name it with package = "..."
+func crateName(name, spec string) string {
+	if m := packageKeyRegex.FindStringSubmatch(spec); m != nil {
+		return m[1]
+	}
+	return name
+}
@@ -0,0 +1,231 @@
+package cargo
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSetDependencyVersions(t *testing.T) {
+	crates := map[string]bool{"demo-core": true, "demo-macros": true}
+
+	tests := []struct {
+		name        string
+		content     string
+		want        string
+		wantChanged bool
+	}{
+		{
+			name:        "inline path dependency",
+			content:     "[dependencies]\ndemo-core = { path = \"../core\", version = \"1.0.0\" }\n",
+			want:        "[dependencies]\ndemo-core = { path = \"../core\", version = \"1.1.0\" }\n",
+			wantChanged: true,
+		},
+		{
+			name:        "operators are kept",
+			content:     "[dependencies]\ndemo-core = { version = \"=1.0.0\", path = \"../core\" }\ndemo-macros = { path = \"../macros\", version = \"^ 1.0.0\" }\n",
+			want:        "[dependencies]\ndemo-core = { version = \"=1.1.0\", path = \"../core\" }\ndemo-macros = { path = \"../macros\", version = \"^ 1.1.0\" }\n",
+			wantChanged: true,
+		},
+		{
+			name:        "renamed dependency",
+			content:     "[dependencies]\ncore = { package = \"demo-core\", path = \"../core\", version = \"1.0.0\" }\n",
+			want:        "[dependencies]\ncore = { package = \"demo-core\", path = \"../core\", version = \"1.1.0\" }\n",
+			wantChanged: true,
+		},
+		{
+			name:        "dev, build and target dependencies",
+			content:     "[dev-dependencies]\ndemo-core = { path = \"../core\", version = \"1.0.0\" }\n\n[build-dependencies]\ndemo-macros = { path = \"../macros\", version = \"1.0.0\" }\n\n[target.'cfg(unix)'.dependencies]\ndemo-core = { path = \"../core\", version = \"1.0.0\" }\n",
+			want:        "[dev-dependencies]\ndemo-core = { path = \"../core\", version = \"1.1.0\" }\n\n[build-dependencies]\ndemo-macros = { path = \"../macros\", version = \"1.1.0\" }\n\n[target.'cfg(unix)'.dependencies]\ndemo-core = { path = \"../core\", version = \"1.1.0\" }\n",
+			wantChanged: true,
+		},
+		{
+			name:        "workspace dependencies",
+			content:     "[workspace.dependencies]\ndemo-core = { path = \"crates/core\", version = \"1.0.0\" }\nserde = { version = \"1.0.0\" }\n",
+			want:        "[workspace.dependencies]\ndemo-core = { path = \"crates/core\", version = \"1.1.0\" }\nserde = { version = \"1.0.0\" }\n",
+			wantChanged: true,
+		},
+		{
+			name:        "dependency table",
+			content:     "[dependencies.demo-core]\npath = \"../core\"\nversion = \"~1.0.0\" # keep in sync\nfeatures = [\"derive\"]\n",
+			want:        "[dependencies.demo-core]\npath = \"../core\"\nversion = \"~1.1.0\" # keep in sync\nfeatures = [\"derive\"]\n",
+			wantChanged: true,
+		},
+		{
+			name:        "renamed dependency table",
+			content:     "[target.'cfg(unix)'.dev-dependencies.macros]\npackage = \"demo-macros\"\nversion = \"1.0.0\"\npath = \"../macros\"\n",
+			want:        "[target.'cfg(unix)'.dev-dependencies.macros]\npackage = \"demo-macros\"\nversion = \"1.1.0\"\npath = \"../macros\"\n",
+			wantChanged: true,
+		},
+		{
+			name:    "registry dependencies are kept",
+			content: "[dependencies]\ndemo-core = { version = \"1.0.0\" }\n\n[dependencies.demo-macros]\nversion = \"1.0.0\"\n",
+			want:    "[dependencies]\ndemo-core = { version = \"1.0.0\" }\n\n[dependencies.demo-macros]\nversion = \"1.0.0\"\n",
+		},
+		{
+			name:    "path dependencies outside the workspace are kept",
+			content: "[dependencies]\nvendored = { path = \"../vendored\", version = \"1.0.0\" }\n",
+			want:    "[dependencies]\nvendored = { path = \"../vendored\", version = \"1.0.0\" }\n",
+		},
+		{
+			name:    "inherited workspace dependency",
+			content: "[dependencies]\ndemo-core = { workspace = true }\ndemo-macros.workspace = true\n",
+			want:    "[dependencies]\ndemo-core = { workspace = true }\ndemo-macros.workspace = true\n",
+		},
+		{
+			name:    "path dependency without version",
+			content: "[dependencies]\ndemo-core = { path = \"../core\" }\n",
+			want:    "[dependencies]\ndemo-core = { path = \"../core\" }\n",
+		},
+		{
+			name:    "comments",
+			content: "[dependencies]\n# demo-core = { path = \"../core\", version = \"1.0.0\" }\n",
+			want:    "[dependencies]\n# demo-core = { path = \"../core\", version = \"1.0.0\" }\n",
+		},
+		{
+			name:    "package version is left to SetTOMLValue",
+			content: "[package]\nname = \"demo-core\"\nversion = \"1.0.0\"\npath = \"src\"\n",
+			want:    "[package]\nname = \"demo-core\"\nversion = \"1.0.0\"\npath = \"src\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := setDependencyVersions(tt.content, crates, "1.1.0")
+			if got != tt.want || changed != tt.wantChanged {
+				t.Errorf("setDependencyVersions() = %q, %v\nwant %q, %v", got, changed, tt.want, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestWorkspaceSetVersion(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	files := map[string]string{
+		"Cargo.toml": `[workspace]
+members = ["crates/*"]
+
+[workspace.package]
+version = "1.0.0" # shared
+
+[workspace.dependencies]
+demo-core = { path = "crates/core", version = "1.0.0" }
+`,
+		"crates/core/Cargo.toml": `[package]
+name = "demo-core"
+version.workspace = true
+`,
+		"crates/cli/Cargo.toml": `[package]
+name = "demo-cli"
+version = "1.0.0"
+
+[dependencies]
+demo-core = { workspace = true }
+
+[dependencies.demo-macros]
+path = "../macros"
+version = "1.0.0"
+`,
+		"crates/macros/Cargo.toml": `[package]
+name = "demo-macros"
+version = "1.0.0"
+`,
+	}
+	for path, content := range files {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ws, err := loadWorkspace()
+	if err != nil {
+		t.Fatalf("loadWorkspace() error = %v", err)
+	}
+	if !ws.virtual {
+		t.Error("workspace without [package] is not virtual")
+	}
+	if want := []string{"--workspace"}; !reflect.DeepEqual(ws.packageFlags(), want) {
+		t.Errorf("packageFlags() = %v, want %v", ws.packageFlags(), want)
+	}
+
+	updated, declared, err := ws.setVersion("1.1.0")
+	if err != nil {
+		t.Fatalf("setVersion() error = %v", err)
+	}
+	if !declared {
+		t.Error("setVersion() found no declared version")
+	}
+
+	want := []string{"Cargo.toml", filepath.FromSlash("crates/cli/Cargo.toml"), filepath.FromSlash("crates/macros/Cargo.toml")}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("setVersion() updated %v, want %v", updated, want)
+	}
+
+	wantFiles := map[string]string{
+		"Cargo.toml": `[workspace]
+members = ["crates/*"]
+
+[workspace.package]
+version = "1.1.0" # shared
+
+[workspace.dependencies]
+demo-core = { path = "crates/core", version = "1.1.0" }
+`,
+		"crates/core/Cargo.toml": files["crates/core/Cargo.toml"],
+		"crates/cli/Cargo.toml": `[package]
+name = "demo-cli"
+version = "1.1.0"
+
+[dependencies]
+demo-core = { workspace = true }
+
+[dependencies.demo-macros]
+path = "../macros"
+version = "1.1.0"
+`,
+		"crates/macros/Cargo.toml": `[package]
+name = "demo-macros"
+version = "1.1.0"
+`,
+	}
+	for path, content := range wantFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != content {
+			t.Errorf("%s =\n%s\nwant\n%s", path, data, content)
+		}
+	}
+}
+
+func TestPackageFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		ws   workspace
+		want []string
+	}{
+		{name: "single crate", ws: workspace{}},
+		{name: "root package with members", ws: workspace{members: []string{"cli/Cargo.toml"}}, want: []string{"--workspace"}},
+		{name: "virtual workspace", ws: workspace{virtual: true}, want: []string{"--workspace"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ws.packageFlags(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("packageFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
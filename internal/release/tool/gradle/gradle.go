@@ -0,0 +1,182 @@
+// Package gradle includes the gradle release-system logic
+package gradle
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+const wrapper = "./gradlew"
+
+// Options are read from tools.gradle in .neko.json
+type Options struct {
+	// Tasks run through the wrapper after the tag is pushed, an empty list disables them
+	Tasks []string `json:"tasks"`
+}
+
+type Gradle struct {
+	release.ToolBase
+}
+
+func (g *Gradle) Name() string {
+	return "gradle"
+}
+
+func (g *Gradle) Init(_ *config.NekoConfig) error {
+	if len(g.options().Tasks) > 0 {
+		g.requireWrapper()
+	}
+
+	file, err := versionFile()
+	if err != nil {
+		errors.Warning("No version declaration found", err.Error())
+	} else {
+		log.Print(log.Init, "Version is managed in %s", log.ColorText(log.ColorCyan, file))
+	}
+
+	log.Print(log.Init, "\uF00C Initialization complete for %s", log.ColorText(log.ColorCyan, g.Name()))
+	return nil
+}
+
+func (g *Gradle) Release(v *semver.Version) error {
+	opts := g.options()
+	if len(opts.Tasks) > 0 {
+		g.requireWrapper()
+	}
+
+	if err := g.updateVersion(v); err != nil {
+		return err
+	}
+
+	if err := g.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := g.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if err := g.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := g.PushGitTag(v); err != nil {
+		return err
+	}
+
+	if len(opts.Tasks) > 0 {
+		g.runGradleTasks(opts.Tasks)
+	}
+
+	return nil
+}
+
+func (g *Gradle) Survey(v *semver.Version) (release.Type, error) {
+	return release.NekoSurvey(v)
+}
+
+func (g *Gradle) SupportsSurvey() bool {
+	return true
+}
+
+// ReleaseFiles returns the file declaring the project version
+func (g *Gradle) ReleaseFiles() []string {
+	file, err := versionFile()
+	if err != nil {
+		return nil
+	}
+	return []string{file}
+}
+
+func (g *Gradle) options() Options {
+	opts := Options{Tasks: []string{"publish"}}
+	if err := g.Config().ToolOptions(g.Name(), &opts); err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+	return opts
+}
+
+// requireWrapper fails when the project has no gradle wrapper, neko never
+// runs a globally installed gradle to keep builds reproducible
+func (g *Gradle) requireWrapper() {
+	log.V(log.Init, fmt.Sprintf("Searching for gradle wrapper: %s",
+		log.ColorText(log.ColorGreen, wrapper)))
+
+	info, err := os.Stat(wrapper)
+	if err != nil || info.IsDir() {
+		errors.Fatal(
+			"Gradle wrapper missing",
+			"No ./gradlew found in the project root.\nGenerate it with: gradle wrapper",
+			errors.ErrGradleWrapperMissing,
+		)
+	}
+
+	log.V(log.Init, fmt.Sprintf("Found gradle wrapper %s", log.ColorText(log.ColorGreen, wrapper)))
+}
+
+func (g *Gradle) updateVersion(v *semver.Version) error {
+	file, err := versionFile()
+	if err != nil {
+		errors.Fatal(
+			"No version declaration found",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	if err := setVersion(file, v.String()); err != nil {
+		errors.Fatal(
+			"Failed to update gradle version",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Updated %s to %s",
+		log.ColorText(log.ColorCyan, file),
+		log.ColorText(log.ColorGreen, v.String()))
+	return nil
+}
+
+func (g *Gradle) runGradleTasks(tasks []string) {
+	args := append([]string{"--no-daemon"}, tasks...)
+
+	log.V(log.Release, fmt.Sprintf("Running gradle tasks: %s",
+		log.ColorText(log.ColorGreen, wrapper+" "+strings.Join(args, " "))))
+
+	cmd := exec.Command(wrapper, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"Gradle task failed",
+			fmt.Sprintf("%s %s failed: %s", wrapper, strings.Join(tasks, " "), strings.TrimSpace(string(output))),
+			errors.ErrGradleExecution,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Gradle %s %s",
+		strings.Join(tasks, " "),
+		log.ColorText(log.ColorGreen, "successful"))
+}
+
+func init() {
+	release.Register(&Gradle{})
+}
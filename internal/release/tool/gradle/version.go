@@ -0,0 +1,66 @@
+package gradle
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// versionFiles are checked in order, gradle.properties wins over the build scripts
+var versionFiles = []struct {
+	path  string
+	regex *regexp.Regexp
+}{
+	{path: "gradle.properties", regex: regexp.MustCompile(`(?m)^(\s*version\s*=\s*)(\S+)(\s*)$`)},
+	{path: "build.gradle", regex: regexp.MustCompile(`(?m)^(\s*version\s*=?\s*["'])([^"']+)(["'])`)},
+	{path: "build.gradle.kts", regex: regexp.MustCompile(`(?m)^(\s*version\s*=\s*")([^"]+)(")`)},
+}
+
+// versionFile returns the first file declaring the project version
+func versionFile() (string, error) {
+	for _, f := range versionFiles {
+		data, err := os.ReadFile(f.path)
+		if err != nil {
+			continue
+		}
+		if f.regex.Match(data) {
+			return f.path, nil
+		}
+	}
+	return "", fmt.Errorf("no version declaration found in gradle.properties, build.gradle or build.gradle.kts")
+}
+
+// setVersion replaces the version declaration of path, keeping its formatting
+func setVersion(path, version string) error {
+	for _, f := range versionFiles {
+		if f.path != path {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+
+		loc := f.regex.FindSubmatchIndex(data)
+		if loc == nil {
+			return fmt.Errorf("no version declaration found in %s", path)
+		}
+
+		updated := append([]byte{}, data[:loc[4]]...)
+		updated = append(updated, version...)
+		updated = append(updated, data[loc[5]:]...)
+
+		if err := os.WriteFile(path, updated, 0644); err != nil {
+			return fmt.Errorf("write %s: %w", path, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s is not a gradle version file", path)
+}
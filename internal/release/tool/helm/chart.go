@@ -0,0 +1,106 @@
+package helm
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/manifest"
+)
+
+const chartFile = "Chart.yaml"
+
+type chart struct {
+	dir  string
+	name string
+}
+
+// discoverCharts returns the configured chart directories, or the root chart,
+// or every chart below charts/
+func discoverCharts(dirs []string) ([]chart, error) {
+	if len(dirs) == 0 {
+		if _, err := os.Stat(chartFile); err == nil {
+			dirs = []string{"."}
+		} else {
+			matches, _ := filepath.Glob(filepath.Join("charts", "*", chartFile))
+			for _, match := range matches {
+				dirs = append(dirs, filepath.Dir(match))
+			}
+		}
+	}
+
+	if len(dirs) == 0 {
+		return nil, fmt.Errorf("no %s found in the project root or charts/*", chartFile)
+	}
+
+	charts := make([]chart, 0, len(dirs))
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(dir, chartFile))
+		if err != nil {
+			return nil, fmt.Errorf("read chart %s: %w", dir, err)
+		}
+
+		name, ok := manifest.YAMLValue(string(data), "name")
+		if !ok {
+			return nil, fmt.Errorf("%s has no name", filepath.Join(dir, chartFile))
+		}
+		charts = append(charts, chart{dir: dir, name: name})
+	}
+	return charts, nil
+}
+
+// tagPattern matches the per-chart tags, e.g. api-1.2.0
+func (c chart) tagPattern() string {
+	return c.name + "-[0-9]*"
+}
+
+func (c chart) tag(version string) string {
+	return fmt.Sprintf("%s-%s", c.name, version)
+}
+
+func (c chart) pkg(dest, version string) string {
+	return filepath.Join(dest, fmt.Sprintf("%s-%s.tgz", c.name, version))
+}
+
+// changed reports whether the chart differs from its last per-chart tag,
+// charts without a tag were never released and always count as changed
+func (c chart) changed() bool {
+	last := git.LatestTagMatching(c.tagPattern())
+	if last == "" {
+		return true
+	}
+	return git.ChangedSince(last, c.dir)
+}
+
+// setVersion updates version and, if requested, appVersion in Chart.yaml
+func (c chart) setVersion(version string, appVersion bool) error {
+	path := filepath.Join(c.dir, chartFile)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+
+	content, changed := manifest.SetYAMLValue(string(data), "version", version)
+	if appVersion {
+		var appChanged bool
+		content, appChanged = manifest.SetYAMLValue(content, "appVersion", version)
+		changed = changed || appChanged
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
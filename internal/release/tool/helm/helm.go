@@ -0,0 +1,283 @@
+// Package helm includes the helm chart release-system logic
+package helm
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+const distDir = "dist"
+
+// Options are read from tools.helm in .neko.json
+type Options struct {
+	// Charts are the chart directories, by default the root chart or charts/*
+	Charts []string `json:"charts,omitempty"`
+	// AppVersion also sets appVersion in Chart.yaml
+	AppVersion bool `json:"app-version,omitempty"`
+	// Repository is a chart repository directory inside the project, packages
+	// and the regenerated index.yaml are part of the release commit
+	Repository string `json:"repository,omitempty"`
+	// RepositoryURL is passed to helm repo index --url
+	RepositoryURL string `json:"repository-url,omitempty"`
+	// OCI is the registry the packages are pushed to, e.g. oci://localhost:5000/charts
+	OCI string `json:"oci,omitempty"`
+	// PlainHTTP allows local registries without TLS
+	PlainHTTP bool `json:"plain-http,omitempty"`
+}
+
+type Helm struct {
+	release.ToolBase
+}
+
+func (h *Helm) Name() string {
+	return "helm"
+}
+
+func (h *Helm) Init(_ *config.NekoConfig) error {
+	h.RequireBinary("helm")
+
+	charts, err := discoverCharts(h.options().Charts)
+	if err != nil {
+		errors.Warning("No helm chart found", err.Error())
+	}
+
+	for _, c := range charts {
+		log.Print(log.Init, "Found chart %s in %s",
+			log.ColorText(log.ColorCyan, c.name),
+			log.ColorText(log.ColorGreen, c.dir))
+	}
+
+	log.Print(log.Init, "\uF00C Initialization complete for %s", log.ColorText(log.ColorCyan, h.Name()))
+	return nil
+}
+
+func (h *Helm) Release(v *semver.Version) error {
+	opts := h.options()
+	h.RequireBinary("helm")
+
+	charts, chartTags := h.selectCharts(opts)
+
+	dest := distDir
+	if opts.Repository != "" {
+		dest = opts.Repository
+	}
+
+	for _, c := range charts {
+		if err := c.setVersion(v.String(), opts.AppVersion); err != nil {
+			errors.Fatal(
+				"Failed to update Chart.yaml",
+				err.Error(),
+				errors.ErrFileAccess,
+			)
+		}
+		log.Print(log.Release, "\uF00C Updated chart %s to %s",
+			log.ColorText(log.ColorCyan, c.name),
+			log.ColorText(log.ColorGreen, v.String()))
+
+		h.runHelm("helm lint failed", "lint", c.dir)
+		h.packageChart(c, dest)
+	}
+
+	if opts.Repository != "" {
+		h.indexRepository(opts)
+	}
+
+	if err := h.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := h.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if chartTags {
+		for _, c := range charts {
+			if err := h.CreateTag(c.tag(v.String())); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := h.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := h.PushGitTag(v); err != nil {
+		return err
+	}
+
+	if chartTags {
+		for _, c := range charts {
+			if err := h.PushTag(c.tag(v.String())); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.OCI != "" {
+		for _, c := range charts {
+			h.pushChart(c.pkg(dest, v.String()), opts)
+		}
+	}
+
+	return nil
+}
+
+func (h *Helm) Survey(v *semver.Version) (release.Type, error) {
+	return release.NekoSurvey(v)
+}
+
+func (h *Helm) SupportsSurvey() bool {
+	return true
+}
+
+// ReleaseFiles returns the Chart.yaml of every chart and the repository index.
+// Unchanged charts of a multi-chart project are listed as well.
+func (h *Helm) ReleaseFiles() []string {
+	opts := h.options()
+	charts, err := discoverCharts(opts.Charts)
+	if err != nil {
+		return nil
+	}
+
+	files := make([]string, 0, len(charts)+1)
+	for _, c := range charts {
+		files = append(files, filepath.Join(c.dir, chartFile))
+	}
+	if opts.Repository != "" {
+		files = append(files, filepath.Join(opts.Repository, "index.yaml"))
+	}
+	return files
+}
+
+func (h *Helm) options() Options {
+	var opts Options
+	if err := h.Config().ToolOptions(h.Name(), &opts); err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+	return opts
+}
+
+// selectCharts returns the charts to release. A single chart is released with
+// the version tag only. Several charts additionally get a tag per chart and
+// only those changed since their last per-chart tag are released.
+func (h *Helm) selectCharts(opts Options) ([]chart, bool) {
+	charts, err := discoverCharts(opts.Charts)
+	if err != nil {
+		errors.Fatal(
+			"No helm chart found",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	if len(charts) == 1 {
+		return charts, false
+	}
+
+	var changed []chart
+	for _, c := range charts {
+		if c.changed() {
+			changed = append(changed, c)
+			continue
+		}
+		log.Print(log.Release, "Skipping chart %s, unchanged since its last tag",
+			log.ColorText(log.ColorCyan, c.name))
+	}
+
+	if len(changed) == 0 {
+		errors.Fatal(
+			"Nothing to release",
+			"No chart changed since its last per-chart tag",
+			errors.ErrHelmExecution,
+		)
+	}
+	return changed, true
+}
+
+func (h *Helm) packageChart(c chart, dest string) {
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		errors.Fatal(
+			"Failed to create package directory",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	h.runHelm("helm package failed", "package", c.dir, "--destination", dest)
+	log.Print(log.Release, "\uF00C Packaged chart %s", log.ColorText(log.ColorCyan, c.name))
+}
+
+// indexRepository regenerates index.yaml and stages the new packages for the release commit
+func (h *Helm) indexRepository(opts Options) {
+	args := []string{"repo", "index", opts.Repository}
+	if opts.RepositoryURL != "" {
+		args = append(args, "--url", opts.RepositoryURL)
+	}
+	h.runHelm("helm repo index failed", args...)
+
+	if err := git.Add(opts.Repository); err != nil {
+		errors.Fatal(
+			"Failed to stage chart repository",
+			err.Error(),
+			errors.ErrReleaseCommit,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Updated chart repository %s", log.ColorText(log.ColorCyan, opts.Repository))
+}
+
+func (h *Helm) pushChart(pkg string, opts Options) {
+	h.runHelm("helm push failed", pushArgs(pkg, opts)...)
+	log.Print(log.Release, "\uF00C Pushed %s to %s",
+		log.ColorText(log.ColorCyan, pkg),
+		log.ColorText(log.ColorGreen, opts.OCI))
+}
+
+// pushArgs returns the helm push arguments of a packaged chart
+func pushArgs(pkg string, opts Options) []string {
+	args := []string{"push", pkg, opts.OCI}
+	if opts.PlainHTTP {
+		args = append(args, "--plain-http")
+	}
+	return args
+}
+
+func (h *Helm) runHelm(title string, args ...string) {
+	log.V(log.Release, fmt.Sprintf("Running helm: %s",
+		log.ColorText(log.ColorGreen, "helm "+strings.Join(args, " "))))
+
+	cmd := exec.Command("helm", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			title,
+			fmt.Sprintf("helm %s failed: %s", strings.Join(args, " "), strings.TrimSpace(string(output))),
+			errors.ErrHelmExecution,
+		)
+	}
+}
+
+func init() {
+	release.Register(&Helm{})
+}
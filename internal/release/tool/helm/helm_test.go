@@ -0,0 +1,206 @@
+package helm
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeChart creates dir/Chart.yaml with content
+func writeChart(t *testing.T, dir, content string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, chartFile), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscoverCharts(t *testing.T) {
+	tests := []struct {
+		name    string
+		charts  map[string]string
+		dirs    []string
+		want    []chart
+		wantErr bool
+	}{
+		{
+			name:   "root chart",
+			charts: map[string]string{".": "name: app\nversion: 1.0.0\n"},
+			want:   []chart{{dir: ".", name: "app"}},
+		},
+		{
+			name: "charts directory",
+			charts: map[string]string{
+				"charts/api": "name: api\nversion: 1.0.0\n",
+				"charts/web": "name: web\nversion: 1.0.0\n",
+			},
+			want: []chart{{dir: "charts/api", name: "api"}, {dir: "charts/web", name: "web"}},
+		},
+		{
+			name: "configured directories win",
+			charts: map[string]string{
+				".":         "name: app\nversion: 1.0.0\n",
+				"deploy/db": "name: db\nversion: 1.0.0\n",
+			},
+			dirs: []string{"deploy/db"},
+			want: []chart{{dir: "deploy/db", name: "db"}},
+		},
+		{
+			name:    "no chart",
+			wantErr: true,
+		},
+		{
+			name:    "chart without name",
+			charts:  map[string]string{".": "version: 1.0.0\n"},
+			wantErr: true,
+		},
+		{
+			name:    "missing configured directory",
+			dirs:    []string{"charts/missing"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			for dir, content := range tt.charts {
+				writeChart(t, dir, content)
+			}
+
+			got, err := discoverCharts(tt.dirs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("discoverCharts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("discoverCharts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChartSetVersion(t *testing.T) {
+	const original = `apiVersion: v2
+name: app
+# bumped by neko
+version: 1.0.0 # chart version
+appVersion: "1.0.0"
+dependencies:
+  - name: db
+    version: 2.0.0
+`
+
+	tests := []struct {
+		name       string
+		appVersion bool
+		want       string
+	}{
+		{
+			name: "version only",
+			want: `apiVersion: v2
+name: app
+# bumped by neko
+version: 1.1.0 # chart version
+appVersion: "1.0.0"
+dependencies:
+  - name: db
+    version: 2.0.0
+`,
+		},
+		{
+			name:       "with app version",
+			appVersion: true,
+			want: `apiVersion: v2
+name: app
+# bumped by neko
+version: 1.1.0 # chart version
+appVersion: "1.1.0"
+dependencies:
+  - name: db
+    version: 2.0.0
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeChart(t, dir, original)
+
+			if err := (chart{dir: dir, name: "app"}).setVersion("1.1.0", tt.appVersion); err != nil {
+				t.Fatalf("setVersion() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, chartFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Chart.yaml =\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want []string
+	}{
+		{
+			name: "tls registry",
+			opts: Options{OCI: "oci://registry.example.com/charts"},
+			want: []string{"push", "dist/app-1.1.0.tgz", "oci://registry.example.com/charts"},
+		},
+		{
+			name: "plain http registry",
+			opts: Options{OCI: "oci://localhost:5000/charts", PlainHTTP: true},
+			want: []string{"push", "dist/app-1.1.0.tgz", "oci://localhost:5000/charts", "--plain-http"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := (chart{dir: ".", name: "app"}).pkg(distDir, "1.1.0")
+			if got := pushArgs(pkg, tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pushArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPushChartToRegistry packages a chart and pushes it to the plain-http
+// registry in NEKO_TEST_OCI, e.g. oci://localhost:5000/charts of
+// docker run -p 5000:5000 registry:2
+func TestPushChartToRegistry(t *testing.T) {
+	registry := os.Getenv("NEKO_TEST_OCI")
+	if registry == "" {
+		t.Skip("NEKO_TEST_OCI not set")
+	}
+	if _, err := exec.LookPath("helm"); err != nil {
+		t.Skip("helm not installed")
+	}
+
+	t.Chdir(t.TempDir())
+	writeChart(t, ".", "apiVersion: v2\nname: neko-test\nversion: 0.1.0\n")
+
+	c := chart{dir: ".", name: "neko-test"}
+	if err := c.setVersion("0.2.0", false); err != nil {
+		t.Fatal(err)
+	}
+
+	h := &Helm{}
+	h.packageChart(c, distDir)
+	h.pushChart(c.pkg(distDir, "0.2.0"), Options{OCI: registry, PlainHTTP: true})
+}
@@ -62,13 +62,21 @@ func (j *JReleaser) Release(v *semver.Version) error {
 		return err
 	}
 
+	// jreleaser creates the tag with the release, post-tag hooks run afterwards
 	if err := j.runJReleaserRelease(); err != nil {
 		return err
 	}
 
+	j.RunHook(config.HookPostTag)
 	return nil
 }
 
+// SupportsPullRequest is false, jreleaser tags HEAD itself instead of the
+// merge commit of the release pull request
+func (j *JReleaser) SupportsPullRequest() bool {
+	return false
+}
+
 func (j *JReleaser) Survey(v *semver.Version) (release.Type, error) {
 	return release.NekoSurvey(v)
 }
@@ -0,0 +1,276 @@
+// Package maven includes the plain maven release-system logic
+package maven
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+const (
+	rootPom     = "pom.xml"
+	wrapper     = "./mvnw"
+	snapshotTag = "SNAPSHOT"
+	// snapshotMessage is a regular commit, it stays in the next changelog
+	snapshotMessage = "chore(maven): prepare next development iteration"
+)
+
+// Options are read from tools.maven in .neko.json
+type Options struct {
+	// DirectEdit updates the pom files without running mvn versions:set
+	DirectEdit bool `json:"direct-edit,omitempty"`
+	// Deploy runs mvn deploy after the tag is pushed
+	Deploy bool `json:"deploy,omitempty"`
+	// Repository is passed as altDeploymentRepository (id::url)
+	Repository string `json:"repository,omitempty"`
+	// Snapshot bumps to the next patch -SNAPSHOT version after the release
+	Snapshot bool `json:"snapshot,omitempty"`
+}
+
+type Maven struct {
+	release.ToolBase
+}
+
+func (m *Maven) Name() string {
+	return "maven"
+}
+
+func (m *Maven) Init(_ *config.NekoConfig) error {
+	if _, err := os.Stat(rootPom); os.IsNotExist(err) {
+		errors.Warning(
+			"Project not correctly initialized",
+			"No pom.xml found - this doesn't appear to be a Maven project",
+		)
+	}
+
+	opts := m.options()
+	if !opts.DirectEdit || opts.Deploy {
+		m.requireMaven()
+	}
+
+	log.Print(log.Init, "\uF00C Initialization complete for %s", log.ColorText(log.ColorCyan, m.Name()))
+	return nil
+}
+
+func (m *Maven) Release(v *semver.Version) error {
+	opts := m.options()
+
+	if err := m.setVersion(v.String(), opts); err != nil {
+		return err
+	}
+
+	if err := m.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := m.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if err := m.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := m.PushGitTag(v); err != nil {
+		return err
+	}
+
+	if opts.Deploy {
+		m.runMavenDeploy(opts)
+	}
+
+	if opts.Snapshot {
+		if release.Finalizing() {
+			// the base branch is protected, the snapshot bump would need another pull request
+			errors.Warning(
+				"Skipping next snapshot",
+				"snapshot is not supported with the pull-request release strategy, bump the snapshot version manually",
+			)
+			return nil
+		}
+		return m.prepareNextSnapshot(v, opts)
+	}
+
+	return nil
+}
+
+func (m *Maven) Survey(v *semver.Version) (release.Type, error) {
+	return release.NekoSurvey(v)
+}
+
+func (m *Maven) SupportsSurvey() bool {
+	return true
+}
+
+// ReleaseFiles returns the poms of the reactor
+func (m *Maven) ReleaseFiles() []string {
+	poms, err := reactor(rootPom)
+	if err != nil {
+		return nil
+	}
+	return poms
+}
+
+func (m *Maven) options() Options {
+	var opts Options
+	if err := m.Config().ToolOptions(m.Name(), &opts); err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+	return opts
+}
+
+// executable prefers the maven wrapper of the project
+func (m *Maven) executable() string {
+	if _, err := os.Stat(wrapper); err == nil {
+		return wrapper
+	}
+	return "mvn"
+}
+
+func (m *Maven) requireMaven() {
+	if m.executable() == wrapper {
+		log.Print(log.Init, "\uF00C Using maven wrapper %s", log.ColorText(log.ColorGreen, wrapper))
+		return
+	}
+	m.RequireBinary("mvn")
+}
+
+// setVersion updates the version of the whole reactor
+func (m *Maven) setVersion(version string, opts Options) error {
+	if opts.DirectEdit {
+		return m.editPomVersions(version)
+	}
+
+	m.requireMaven()
+	args := []string{
+		"-B", "-q",
+		"versions:set",
+		"-DnewVersion=" + version,
+		"-DgenerateBackupPoms=false",
+		"-DprocessAllModules=true",
+	}
+
+	log.V(log.Release, fmt.Sprintf("Setting maven version: %s",
+		log.ColorText(log.ColorGreen, m.executable()+" "+strings.Join(args, " "))))
+
+	cmd := exec.Command(m.executable(), args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"Maven version update failed",
+			fmt.Sprintf("versions:set failed: %s", strings.TrimSpace(string(output))),
+			errors.ErrMavenExecution,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Maven version updated to %s", log.ColorText(log.ColorGreen, version))
+	return nil
+}
+
+// editPomVersions rewrites the versions in all reactor poms without maven
+func (m *Maven) editPomVersions(version string) error {
+	poms, err := reactor(rootPom)
+	if err != nil {
+		errors.Fatal(
+			"Failed to read maven reactor",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	data, err := os.ReadFile(rootPom)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", rootPom, err)
+	}
+
+	oldVersion := projectVersion(string(data))
+	if oldVersion == "" {
+		return fmt.Errorf("no project version found in %s", rootPom)
+	}
+
+	for _, pom := range poms {
+		changed, err := setPomVersion(pom, oldVersion, version)
+		if err != nil {
+			errors.Fatal(
+				"Failed to update pom version",
+				err.Error(),
+				errors.ErrFileAccess,
+			)
+		}
+
+		if changed {
+			log.V(log.Release, fmt.Sprintf("Updated %s (%s \uF178 %s)", pom, oldVersion, version))
+		}
+	}
+
+	log.Print(log.Release, "\uF00C Updated %d pom files to %s",
+		len(poms), log.ColorText(log.ColorGreen, version))
+	return nil
+}
+
+func (m *Maven) runMavenDeploy(opts Options) {
+	args := []string{"-B", "deploy"}
+	if opts.Repository != "" {
+		args = append(args, "-DaltDeploymentRepository="+opts.Repository)
+	}
+
+	log.V(log.Release, fmt.Sprintf("Deploying maven artifacts: %s",
+		log.ColorText(log.ColorGreen, m.executable()+" "+strings.Join(args, " "))))
+
+	cmd := exec.Command(m.executable(), args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"Maven deploy failed",
+			fmt.Sprintf("mvn deploy failed: %s", strings.TrimSpace(string(output))),
+			errors.ErrMavenExecution,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Maven deploy %s", log.ColorText(log.ColorGreen, "successful"))
+}
+
+// prepareNextSnapshot bumps the reactor to x.y.z+1-SNAPSHOT and pushes it
+// with a plain commit, release hooks and the release commit message are not used
+func (m *Maven) prepareNextSnapshot(v *semver.Version, opts Options) error {
+	next, err := v.IncPatch().SetPrerelease(snapshotTag)
+	if err != nil {
+		return fmt.Errorf("compute snapshot version: %w", err)
+	}
+
+	if err := m.setVersion(next.String(), opts); err != nil {
+		return err
+	}
+
+	poms, err := reactor(rootPom)
+	if err != nil {
+		return fmt.Errorf("read maven reactor: %w", err)
+	}
+
+	if err := m.CreateCommit(snapshotMessage, poms...); err != nil {
+		return err
+	}
+
+	return m.PushCommits()
+}
+
+func init() {
+	release.Register(&Maven{})
+}
@@ -0,0 +1,116 @@
+package maven
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	parentBlockRegex = regexp.MustCompile(`(?s)<parent>.*?</parent>`)
+	versionRegex     = regexp.MustCompile(`<version>\s*([^<\s]+)\s*</version>`)
+	moduleRegex      = regexp.MustCompile(`<module>\s*([^<\s]+)\s*</module>`)
+	// sectionRegex matches blocks whose <version> tags never belong to the project itself
+	sectionRegex = regexp.MustCompile(`(?s)<(dependencies|dependencyManagement|build|reporting|profiles|plugins)>.*?</(dependencies|dependencyManagement|build|reporting|profiles|plugins)>`)
+)
+
+// reactor returns the root pom and the poms of all modules, recursively
+func reactor(root string) ([]string, error) {
+	var poms []string
+	seen := make(map[string]bool)
+
+	var walk func(pom string) error
+	walk = func(pom string) error {
+		abs, err := filepath.Abs(pom)
+		if err != nil {
+			return err
+		}
+		if seen[abs] {
+			return nil
+		}
+		seen[abs] = true
+
+		data, err := os.ReadFile(pom)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", pom, err)
+		}
+		poms = append(poms, pom)
+
+		for _, m := range moduleRegex.FindAllStringSubmatch(string(data), -1) {
+			module := filepath.Join(filepath.Dir(pom), m[1])
+			if !strings.HasSuffix(module, ".xml") {
+				module = filepath.Join(module, "pom.xml")
+			}
+			if err := walk(module); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+	return poms, nil
+}
+
+// projectVersion returns the version of the project, ignoring parent and dependency versions
+func projectVersion(content string) string {
+	if m := versionRegex.FindStringSubmatch(projectHead(content)); len(m) == 2 {
+		return m[1]
+	}
+	return ""
+}
+
+// projectHead strips every block that may contain foreign <version> tags
+func projectHead(content string) string {
+	content = parentBlockRegex.ReplaceAllStringFunc(content, blank)
+	return sectionRegex.ReplaceAllStringFunc(content, blank)
+}
+
+// blank keeps offsets intact so matches in the stripped content map to the original
+func blank(s string) string {
+	return strings.Repeat(" ", len(s))
+}
+
+// setPomVersion replaces the project version and the parent version of a
+// single pom when they reference the old reactor version
+func setPomVersion(path, oldVersion, newVersion string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("read %s: %w", path, err)
+	}
+	content := string(data)
+	updated := content
+
+	if loc := versionRegex.FindStringSubmatchIndex(projectHead(content)); loc != nil {
+		if content[loc[2]:loc[3]] == oldVersion {
+			updated = content[:loc[2]] + newVersion + content[loc[3]:]
+		}
+	}
+
+	if loc := parentBlockRegex.FindStringIndex(updated); loc != nil {
+		parent := updated[loc[0]:loc[1]]
+		if m := versionRegex.FindStringSubmatchIndex(parent); m != nil && parent[m[2]:m[3]] == oldVersion {
+			parent = parent[:m[2]] + newVersion + parent[m[3]:]
+			updated = updated[:loc[0]] + parent + updated[loc[1]:]
+		}
+	}
+
+	if updated == content {
+		return false, nil
+	}
+
+	if err := os.WriteFile(path, []byte(updated), 0644); err != nil {
+		return false, fmt.Errorf("write %s: %w", path, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,302 @@
+package maven
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writePom creates path with content, including its directories
+func writePom(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReactor(t *testing.T) {
+	tests := []struct {
+		name    string
+		poms    map[string]string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "single pom",
+			poms: map[string]string{"pom.xml": "<project><version>1.0.0</version></project>"},
+			want: []string{"pom.xml"},
+		},
+		{
+			name: "nested modules",
+			poms: map[string]string{
+				"pom.xml":            "<project><modules><module>api</module><module> core </module></modules></project>",
+				"api/pom.xml":        "<project><modules><module>client</module></modules></project>",
+				"api/client/pom.xml": "<project/>",
+				"core/pom.xml":       "<project/>",
+			},
+			want: []string{"pom.xml", "api/pom.xml", "api/client/pom.xml", "core/pom.xml"},
+		},
+		{
+			name: "module pointing at a pom file",
+			poms: map[string]string{
+				"pom.xml":       "<project><modules><module>build/bom.xml</module></modules></project>",
+				"build/bom.xml": "<project/>",
+			},
+			want: []string{"pom.xml", "build/bom.xml"},
+		},
+		{
+			name: "module listed twice",
+			poms: map[string]string{
+				"pom.xml":     "<project><modules><module>api</module></modules><profiles><profile><modules><module>api</module></modules></profile></profiles></project>",
+				"api/pom.xml": "<project/>",
+			},
+			want: []string{"pom.xml", "api/pom.xml"},
+		},
+		{
+			name:    "missing module",
+			poms:    map[string]string{"pom.xml": "<project><modules><module>api</module></modules></project>"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			for path, content := range tt.poms {
+				writePom(t, path, content)
+			}
+
+			got, err := reactor("pom.xml")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reactor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			want := make([]string, 0, len(tt.want))
+			for _, pom := range tt.want {
+				want = append(want, filepath.FromSlash(pom))
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("reactor() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSetPomVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		pom         string
+		want        string
+		wantChanged bool
+	}{
+		{
+			name: "project version",
+			pom: `<project>
+  <groupId>at.nekoman</groupId>
+  <artifactId>app</artifactId>
+  <version>1.0.0</version>
+</project>`,
+			want: `<project>
+  <groupId>at.nekoman</groupId>
+  <artifactId>app</artifactId>
+  <version>1.1.0</version>
+</project>`,
+			wantChanged: true,
+		},
+		{
+			name: "reactor parent and project version",
+			pom: `<project>
+  <parent>
+    <groupId>at.nekoman</groupId>
+    <artifactId>parent</artifactId>
+    <version>1.0.0</version>
+  </parent>
+  <artifactId>api</artifactId>
+  <version>1.0.0</version>
+</project>`,
+			want: `<project>
+  <parent>
+    <groupId>at.nekoman</groupId>
+    <artifactId>parent</artifactId>
+    <version>1.1.0</version>
+  </parent>
+  <artifactId>api</artifactId>
+  <version>1.1.0</version>
+</project>`,
+			wantChanged: true,
+		},
+		{
+			name: "module inheriting the parent version",
+			pom: `<project>
+  <parent>
+    <artifactId>parent</artifactId>
+    <version>1.0.0</version>
+  </parent>
+  <artifactId>core</artifactId>
+</project>`,
+			want: `<project>
+  <parent>
+    <artifactId>parent</artifactId>
+    <version>1.1.0</version>
+  </parent>
+  <artifactId>core</artifactId>
+</project>`,
+			wantChanged: true,
+		},
+		{
+			name: "external parent is kept",
+			pom: `<project>
+  <parent>
+    <groupId>org.springframework.boot</groupId>
+    <artifactId>spring-boot-starter-parent</artifactId>
+    <version>3.2.0</version>
+  </parent>
+  <artifactId>app</artifactId>
+  <version>1.0.0</version>
+</project>`,
+			want: `<project>
+  <parent>
+    <groupId>org.springframework.boot</groupId>
+    <artifactId>spring-boot-starter-parent</artifactId>
+    <version>3.2.0</version>
+  </parent>
+  <artifactId>app</artifactId>
+  <version>1.1.0</version>
+</project>`,
+			wantChanged: true,
+		},
+		{
+			name: "dependency and plugin versions are kept",
+			pom: `<project>
+  <artifactId>app</artifactId>
+  <dependencies>
+    <dependency>
+      <artifactId>lib</artifactId>
+      <version>1.0.0</version>
+    </dependency>
+  </dependencies>
+  <version>1.0.0</version>
+  <build>
+    <plugins>
+      <plugin>
+        <artifactId>maven-jar-plugin</artifactId>
+        <version>1.0.0</version>
+      </plugin>
+    </plugins>
+  </build>
+</project>`,
+			want: `<project>
+  <artifactId>app</artifactId>
+  <dependencies>
+    <dependency>
+      <artifactId>lib</artifactId>
+      <version>1.0.0</version>
+    </dependency>
+  </dependencies>
+  <version>1.1.0</version>
+  <build>
+    <plugins>
+      <plugin>
+        <artifactId>maven-jar-plugin</artifactId>
+        <version>1.0.0</version>
+      </plugin>
+    </plugins>
+  </build>
+</project>`,
+			wantChanged: true,
+		},
+		{
+			name: "managed dependency versions are kept",
+			pom: `<project>
+  <version>2.0.0</version>
+  <dependencyManagement>
+    <dependencies>
+      <dependency>
+        <artifactId>api</artifactId>
+        <version>1.0.0</version>
+      </dependency>
+    </dependencies>
+  </dependencyManagement>
+</project>`,
+			want: `<project>
+  <version>2.0.0</version>
+  <dependencyManagement>
+    <dependencies>
+      <dependency>
+        <artifactId>api</artifactId>
+        <version>1.0.0</version>
+      </dependency>
+    </dependencies>
+  </dependencyManagement>
+</project>`,
+		},
+		{
+			name:        "whitespace around the version",
+			pom:         "<project><version>\n    1.0.0\n  </version></project>",
+			want:        "<project><version>\n    1.1.0\n  </version></project>",
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "pom.xml")
+			writePom(t, path, tt.pom)
+
+			changed, err := setPomVersion(path, "1.0.0", "1.1.0")
+			if err != nil {
+				t.Fatalf("setPomVersion() error = %v", err)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("setPomVersion() changed = %v, want %v", changed, tt.wantChanged)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("pom.xml =\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		pom  string
+		want string
+	}{
+		{
+			name: "own version",
+			pom:  "<project><parent><version>3.2.0</version></parent><version>1.0.0</version></project>",
+			want: "1.0.0",
+		},
+		{
+			name: "inherited version",
+			pom:  "<project><parent><version>3.2.0</version></parent><dependencies><dependency><version>2.0.0</version></dependency></dependencies></project>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := projectVersion(tt.pom); got != tt.want {
+				t.Errorf("projectVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Package native includes the built-in neko release-system which needs no external tool
+package native
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/changelog"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/git/github"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+// Options are read from tools.neko in .neko.json
+type Options struct {
+	// Artifacts are glob patterns of files uploaded to the hosted release
+	Artifacts []string `json:"artifacts,omitempty"`
+	Draft     bool     `json:"draft,omitempty"`
+}
+
+type Native struct {
+	release.ToolBase
+}
+
+func (n *Native) Name() string {
+	return "neko"
+}
+
+func (n *Native) Init(_ *config.NekoConfig) error {
+	log.Print(log.Init, "\uF00C %s needs no external tool, releases are created by neko itself",
+		log.ColorText(log.ColorCyan, n.Name()))
+
+	opts, err := n.options()
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Artifacts) == 0 {
+		log.Print(log.Init, "Add %s to .neko.json to upload build artifacts",
+			log.ColorText(log.ColorCyan, `"tools": {"neko": {"artifacts": ["dist/*"]}}`))
+	}
+	return nil
+}
+
+func (n *Native) Release(v *semver.Version) error {
+	opts, err := n.options()
+	if err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+
+	artifacts := n.resolveArtifacts(opts.Artifacts)
+	notes := n.releaseNotes(v)
+
+	if err := n.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := n.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if err := n.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := n.PushGitTag(v); err != nil {
+		return err
+	}
+
+	// the tag is public from here on, a failure must not lead to a second
+	// release commit and tag by re-running neko release
+	hosted, err := n.createHostedRelease(v, notes, opts.Draft)
+	if err == nil {
+		err = n.uploadArtifacts(hosted, artifacts)
+	}
+	if err != nil {
+		return fmt.Errorf("tag v%s was pushed but the GitHub release is incomplete, finish it on GitHub "+
+			"and do not re-run neko release: %w", v, err)
+	}
+
+	return nil
+}
+
+func (n *Native) Survey(v *semver.Version) (release.Type, error) {
+	return release.NekoSurvey(v)
+}
+
+func (n *Native) SupportsSurvey() bool {
+	return true
+}
+
+func (n *Native) options() (*Options, error) {
+	opts := &Options{}
+	if err := n.Config().ToolOptions(n.Name(), opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// releaseNotes renders the changelog since the previous tag
+func (n *Native) releaseNotes(v *semver.Version) string {
+	previous := git.LatestTag()
+	if !git.TagExists(previous) {
+		previous = ""
+	}
+
+	notes, err := changelog.Generate(fmt.Sprintf("v%s", v), previous, "HEAD", n.Config().GitOptions().CommitPrefix())
+	if err != nil {
+		errors.Warning("Changelog generation failed", err.Error())
+		return ""
+	}
+
+	log.V(log.Release, fmt.Sprintf("Generated release notes:\n%s", notes))
+	return notes
+}
+
+// resolveArtifacts expands the artifact globs before anything is pushed so a
+// broken pattern fails the release early
+func (n *Native) resolveArtifacts(patterns []string) []string {
+	var files []string
+	seen := make(map[string]bool)
+
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			errors.Fatal(
+				"Invalid artifact pattern",
+				fmt.Sprintf("%s is not a valid glob: %s", pattern, err.Error()),
+				errors.ErrConfigMarshal,
+			)
+		}
+
+		if len(matches) == 0 {
+			errors.Warning("No artifacts found", fmt.Sprintf("Pattern %s matched no files", pattern))
+		}
+
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+	}
+
+	sort.Strings(files)
+	return files
+}
+
+func (n *Native) createHostedRelease(v *semver.Version, notes string, draft bool) (*github.Release, error) {
+	repoInfo, _ := git.Current()
+	tag := fmt.Sprintf("v%s", v)
+
+	hosted, err := git.CreateRelease(repoInfo, github.ReleaseRequest{
+		TagName:    tag,
+		Name:       fmt.Sprintf("%s@%s", repoInfo.Repo, v),
+		Body:       notes,
+		Draft:      draft,
+		PreRelease: v.Prerelease() != "",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create release: %w", err)
+	}
+
+	log.Print(log.Release, "\uF00C Created release %s",
+		log.ColorText(log.ColorGreen, hosted.HTMLURL))
+	return hosted, nil
+}
+
+func (n *Native) uploadArtifacts(hosted *github.Release, artifacts []string) error {
+	for _, path := range artifacts {
+		asset, err := git.UploadReleaseAsset(hosted, path)
+		if err != nil {
+			return fmt.Errorf("upload artifact %s: %w", path, err)
+		}
+
+		log.Print(log.Release, "\uF00C Uploaded %s",
+			log.ColorText(log.ColorGreen, asset.Name))
+	}
+	return nil
+}
+
+func init() {
+	release.Register(&Native{})
+}
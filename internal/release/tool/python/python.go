@@ -0,0 +1,282 @@
+// Package python includes the python packaging release-system logic
+package python
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+const distDir = "dist"
+
+// Options are read from tools.python in .neko.json
+type Options struct {
+	// Interpreter runs the build module, defaults to python3
+	Interpreter string `json:"interpreter,omitempty"`
+	// VersionFiles contain a __version__ = "x.y.z" assignment to update
+	VersionFiles []string `json:"version-files,omitempty"`
+	// Upload runs twine upload after the tag is pushed
+	Upload bool `json:"upload,omitempty"`
+	// RepositoryURL is passed to twine, empty means PyPI
+	RepositoryURL string `json:"repository-url,omitempty"`
+}
+
+type Python struct {
+	release.ToolBase
+}
+
+func (p *Python) Name() string {
+	return "python"
+}
+
+func (p *Python) Init(_ *config.NekoConfig) error {
+	opts := p.options()
+	p.RequireBinary(opts.Interpreter)
+	p.checkBuildModule(opts)
+
+	if opts.Upload {
+		p.RequireBinary("twine")
+	}
+
+	log.Print(log.Init, "\uF00C Initialization complete for %s", log.ColorText(log.ColorCyan, p.Name()))
+	return nil
+}
+
+func (p *Python) Release(v *semver.Version) error {
+	opts := p.options()
+	p.RequireBinary(opts.Interpreter)
+	if opts.Upload {
+		p.RequireBinary("twine")
+	}
+
+	p.updateVersion(v, opts)
+	p.runBuild(opts)
+
+	if err := p.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := p.CreateGitTag(v); err != nil {
+		return err
+	}
+
+	if err := p.PushCommits(); err != nil {
+		return err
+	}
+
+	if err := p.PushGitTag(v); err != nil {
+		return err
+	}
+
+	if opts.Upload {
+		p.runTwineUpload(v, opts)
+	}
+
+	return nil
+}
+
+func (p *Python) Survey(v *semver.Version) (release.Type, error) {
+	return release.NekoSurvey(v)
+}
+
+func (p *Python) SupportsSurvey() bool {
+	return true
+}
+
+// ReleaseFiles returns pyproject.toml and the configured version files
+func (p *Python) ReleaseFiles() []string {
+	var files []string
+	if _, err := os.Stat(pyproject); err == nil {
+		files = append(files, pyproject)
+	}
+	return append(files, p.options().VersionFiles...)
+}
+
+func (p *Python) options() Options {
+	opts := Options{Interpreter: "python3"}
+	if err := p.Config().ToolOptions(p.Name(), &opts); err != nil {
+		errors.Fatal(
+			"Invalid configuration",
+			err.Error(),
+			errors.ErrConfigMarshal,
+		)
+	}
+	return opts
+}
+
+// updateVersion writes the PEP 440 form of v, e.g. 1.0.0rc1 for 1.0.0-rc.1,
+// which build tools keep as is
+func (p *Python) updateVersion(v *semver.Version, opts Options) {
+	version, err := pep440(v)
+	if err != nil {
+		errors.Fatal(
+			"Invalid python version",
+			err.Error(),
+			errors.ErrVersionViolation,
+		)
+	}
+
+	updated, err := setVersion(version, opts.VersionFiles)
+	if err != nil {
+		errors.Fatal(
+			"Failed to update python version",
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+	}
+
+	for _, path := range updated {
+		log.Print(log.Release, "\uF00C Updated %s to %s",
+			log.ColorText(log.ColorCyan, path),
+			log.ColorText(log.ColorGreen, version))
+	}
+}
+
+func (p *Python) checkBuildModule(opts Options) {
+	p.runPython(opts, "Python build module missing", "-m", "build", "--version")
+	log.Print(log.Init, "\uF00C Found %s", log.ColorText(log.ColorCyan, "python -m build"))
+}
+
+// runBuild builds sdist and wheel, it also acts as dry run before anything is committed
+func (p *Python) runBuild(opts Options) {
+	p.runPython(opts, "Python build failed", "-m", "build", "--outdir", distDir)
+	log.Print(log.Release, "\uF00C Python build %s", log.ColorText(log.ColorGreen, "successful"))
+}
+
+func (p *Python) runTwineUpload(v *semver.Version, opts Options) {
+	files := distributions(v)
+	if len(files) == 0 {
+		errors.Fatal(
+			"No distributions found",
+			fmt.Sprintf("No files for version %s found in %s", v, distDir),
+			errors.ErrPythonExecution,
+		)
+	}
+
+	args := []string{"upload", "--non-interactive"}
+	if opts.RepositoryURL != "" {
+		args = append(args, "--repository-url", opts.RepositoryURL)
+	}
+	args = append(args, files...)
+
+	log.V(log.Release, fmt.Sprintf("Uploading distributions: %s",
+		log.ColorText(log.ColorGreen, "twine "+strings.Join(args, " "))))
+
+	cmd := exec.Command("twine", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"twine upload failed",
+			fmt.Sprintf("twine upload failed: %s", strings.TrimSpace(string(output))),
+			errors.ErrPythonExecution,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C Uploaded %d distributions", len(files))
+}
+
+func (p *Python) runPython(opts Options, title string, args ...string) {
+	log.V(log.Release, fmt.Sprintf("Running python: %s",
+		log.ColorText(log.ColorGreen, opts.Interpreter+" "+strings.Join(args, " "))))
+
+	cmd := exec.Command(opts.Interpreter, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			title,
+			fmt.Sprintf("%s %s failed: %s", opts.Interpreter, strings.Join(args, " "), strings.TrimSpace(string(output))),
+			errors.ErrPythonExecution,
+		)
+	}
+}
+
+// distributions returns the sdist and wheels of this version only, older
+// builds left in dist/ are never uploaded. Pre- and post-releases of the
+// version like 1.2.0.dev1 do not match.
+func distributions(v *semver.Version) []string {
+	versions := []string{v.String()}
+	if version, err := pep440(v); err == nil {
+		versions = append(versions, version)
+	}
+
+	var files []string
+	for _, version := range versions {
+		sdists, _ := filepath.Glob(filepath.Join(distDir, fmt.Sprintf("*-%s.tar.gz", version)))
+		wheels, _ := filepath.Glob(filepath.Join(distDir, fmt.Sprintf("*-%s-*.whl", version)))
+		for _, match := range append(sdists, wheels...) {
+			if !contains(files, match) {
+				files = append(files, match)
+			}
+		}
+	}
+	return files
+}
+
+// pep440Labels maps semver pre-release labels to their normalised PEP 440 form.
+// There is no post label, a post-release sorts after its release while a
+// semver pre-release sorts before it.
+var pep440Labels = map[string]string{
+	"a": "a", "alpha": "a",
+	"b": "b", "beta": "b",
+	"c": "rc", "rc": "rc", "pre": "rc", "preview": "rc",
+	"dev": ".dev",
+}
+
+var prereleaseRegex = regexp.MustCompile(`^([a-zA-Z]+)\.?(\d*)$`)
+
+// pep440 converts semver versions into normalised PEP 440 versions, e.g.
+// 1.0.0-rc.1 into 1.0.0rc1 and 1.0.0-beta.2+abc into 1.0.0b2+abc
+func pep440(v *semver.Version) (string, error) {
+	version := fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch())
+
+	if pre := v.Prerelease(); pre != "" {
+		m := prereleaseRegex.FindStringSubmatch(pre)
+		if m == nil {
+			return "", fmt.Errorf("pre-release %q of %s has no PEP 440 form, use a label like rc.1, beta.2 or dev.3", pre, v)
+		}
+
+		label, ok := pep440Labels[strings.ToLower(m[1])]
+		if !ok {
+			return "", fmt.Errorf("pre-release label %q of %s has no PEP 440 form (valid: a, alpha, b, beta, rc, c, pre, preview, dev)", m[1], v)
+		}
+
+		number := m[2]
+		if number == "" {
+			number = "0"
+		}
+		version += label + number
+	}
+
+	if meta := v.Metadata(); meta != "" {
+		version += "+" + meta
+	}
+	return version, nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	release.Register(&Python{})
+}
@@ -0,0 +1,120 @@
+package python
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func TestPEP440(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr bool
+	}{
+		{version: "1.2.0", want: "1.2.0"},
+		{version: "1.2.0-alpha.1", want: "1.2.0a1"},
+		{version: "1.2.0-a1", want: "1.2.0a1"},
+		{version: "1.2.0-beta.2", want: "1.2.0b2"},
+		{version: "1.2.0-rc.1", want: "1.2.0rc1"},
+		{version: "1.2.0-c.3", want: "1.2.0rc3"},
+		{version: "1.2.0-pre.1", want: "1.2.0rc1"},
+		{version: "1.2.0-preview.4", want: "1.2.0rc4"},
+		{version: "1.2.0-RC.1", want: "1.2.0rc1"},
+		{version: "1.2.0-rc", want: "1.2.0rc0"},
+		{version: "1.2.0-dev.3", want: "1.2.0.dev3"},
+		{version: "1.2.0-beta.2+abc", want: "1.2.0b2+abc"},
+		{version: "1.2.0+build.5", want: "1.2.0+build.5"},
+		{version: "1.2.0-post.1", wantErr: true},
+		{version: "1.2.0-snapshot", wantErr: true},
+		{version: "1.2.0-rc.1.2", wantErr: true},
+		{version: "1.2.0-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := pep440(semver.MustParse(tt.version))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pep440(%s) error = %v, wantErr %v", tt.version, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("pep440(%s) = %q, want %q", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistributions(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		files   []string
+		want    []string
+	}{
+		{
+			name:    "release",
+			version: "1.2.0",
+			files: []string{
+				"demo-1.2.0.tar.gz",
+				"demo-1.2.0-py3-none-any.whl",
+				"demo-1.1.0.tar.gz",
+				"demo-1.1.0-py3-none-any.whl",
+				"demo-1.2.0.dev1.tar.gz",
+				"demo-1.2.0.dev1-py3-none-any.whl",
+				"demo-1.2.0.post1.tar.gz",
+				"demo-1.2.0rc1-py3-none-any.whl",
+				"demo-1.2.0.1.tar.gz",
+			},
+			want: []string{"dist/demo-1.2.0-py3-none-any.whl", "dist/demo-1.2.0.tar.gz"},
+		},
+		{
+			name:    "pre-release in PEP 440 form",
+			version: "1.2.0-rc.1",
+			files: []string{
+				"demo-1.2.0rc1.tar.gz",
+				"demo-1.2.0rc1-cp312-cp312-manylinux_2_17_x86_64.whl",
+				"demo-1.2.0rc10.tar.gz",
+				"demo-1.2.0.tar.gz",
+			},
+			want: []string{"dist/demo-1.2.0rc1-cp312-cp312-manylinux_2_17_x86_64.whl", "dist/demo-1.2.0rc1.tar.gz"},
+		},
+		{
+			name:    "nothing built",
+			version: "1.2.0",
+			files:   []string{"demo-1.1.0.tar.gz"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			if err := os.MkdirAll(distDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			for _, file := range tt.files {
+				if err := os.WriteFile(filepath.Join(distDir, file), nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := distributions(semver.MustParse(tt.version))
+			sort.Strings(got)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("distributions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package python
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/nekoman-hq/neko-cli/internal/manifest"
+)
+
+const pyproject = "pyproject.toml"
+
+var dunderVersionRegex = regexp.MustCompile(`(?m)^(__version__\s*=\s*["'])([^"']+)(["'])`)
+
+// pyprojectTables hold the version in PEP 621 and Poetry layouts
+var pyprojectTables = []string{"project", "tool.poetry"}
+
+// setVersion updates pyproject.toml and all __version__ files. Projects with
+// a dynamic version only declare it in the version files.
+func setVersion(version string, versionFiles []string) ([]string, error) {
+	var updated []string
+	declared := false
+
+	if data, err := os.ReadFile(pyproject); err == nil {
+		content := string(data)
+		changed := false
+
+		for _, table := range pyprojectTables {
+			if _, ok := manifest.TOMLValue(content, table, "version"); !ok {
+				continue
+			}
+			declared = true
+
+			var tableChanged bool
+			content, tableChanged = manifest.SetTOMLValue(content, table, "version", version)
+			changed = changed || tableChanged
+		}
+
+		if changed {
+			if err := os.WriteFile(pyproject, []byte(content), 0644); err != nil {
+				return nil, fmt.Errorf("write %s: %w", pyproject, err)
+			}
+			updated = append(updated, pyproject)
+		}
+	}
+
+	for _, path := range versionFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+
+		loc := dunderVersionRegex.FindSubmatchIndex(data)
+		if loc == nil {
+			return nil, fmt.Errorf("no __version__ assignment found in %s", path)
+		}
+		declared = true
+
+		content := string(data[:loc[4]]) + version + string(data[loc[5]:])
+		if content == string(data) {
+			continue
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return nil, fmt.Errorf("write %s: %w", path, err)
+		}
+		updated = append(updated, path)
+	}
+
+	if !declared {
+		return nil, fmt.Errorf("no version found in [project] or [tool.poetry] of %s and no version-files configured", pyproject)
+	}
+	return updated, nil
+}
@@ -9,9 +9,15 @@ package tool
 
 import (
 	// Register all release tools
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/cargo"
 	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/goreleaser"
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/gradle"
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/helm"
 	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/jreleaser"
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/maven"
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/native"
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/python"
 	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/releaseit"
-	// _ "git.com/nekoman-hq/neko-cli/internal/release/semantic-release"
+	_ "github.com/nekoman-hq/neko-cli/internal/release/tool/semanticrelease"
 	// More tools here
 )
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/nekoman-hq/neko-cli/internal/config"
 	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
 	"github.com/nekoman-hq/neko-cli/internal/log"
 	"github.com/nekoman-hq/neko-cli/internal/release"
 )
@@ -30,12 +32,22 @@ func (r *ReleaseIt) Init(cfg *config.NekoConfig) error {
 }
 
 func (r *ReleaseIt) Release(v *semver.Version) error {
+	// release-it commits and tags itself, the hooks wrap the whole run
+	r.RunHook(config.HookPreCommit)
+
 	if err := r.runReleaseItRelease(v); err != nil {
 		return err
 	}
+
+	r.RunHook(config.HookPostTag)
 	return nil
 }
 
+// SupportsPullRequest is false, release-it commits, tags and pushes in one run
+func (r *ReleaseIt) SupportsPullRequest() bool {
+	return false
+}
+
 func (r *ReleaseIt) Survey(v *semver.Version) (release.Type, error) {
 	return release.NekoSurvey(v)
 }
@@ -124,16 +136,29 @@ func (r *ReleaseIt) runReleaseItCheck() {
 
 func (r *ReleaseIt) runReleaseItRelease(v *semver.Version) error {
 	versionStr := v.String()
+	// the neko commit message replaces commitMessage of .release-it.json
+	commitMessage := fmt.Sprintf("--git.commitMessage=%s", r.CommitMessage(v))
+	pushRepo := fmt.Sprintf("--git.pushRepo=%s", git.Remote())
+
+	args := []string{"release-it", versionStr, "--ci", "--no-git.requireCleanWorkingDir", pushRepo, commitMessage}
+	// signed releases pass the neko signing flags, the tag is verified afterwards
+	if commitSign, tagSign := r.SigningFlags(); commitSign != "" {
+		args = append(args, "--git.commitArgs="+commitSign, "--git.tagArgs="+tagSign)
+	}
+
 	log.V(log.Release,
 		fmt.Sprintf("Running release-it: %s",
-			log.ColorText(log.ColorGreen, fmt.Sprintf("npx release-it %s --ci --no-git.requireCleanWorkingDir", versionStr)),
+			log.ColorText(log.ColorGreen, "npx "+strings.Join(args, " ")),
 		),
 	)
-	cmd := exec.Command("npx", "release-it", versionStr, "--ci", "--no-git.requireCleanWorkingDir")
+	cmd := exec.Command("npx", args...)
+	cmd.Env = r.GitEnv()
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("release failed: %s\nOutput: %s", err.Error(), string(output))
 	}
+
+	r.VerifySignature(fmt.Sprintf("v%s", v))
 	return nil
 }
 
@@ -0,0 +1,55 @@
+package semanticrelease
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const configFile = ".releaserc.json"
+
+type Config struct {
+	Branches  []string `json:"branches"`
+	TagFormat string   `json:"tagFormat"`
+	Plugins   []string `json:"plugins"`
+}
+
+func SaveConfig(cfg *Config) (err error) {
+	file, err := os.Create(configFile)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", configFile, err)
+	}
+	defer func() {
+		if cerr := file.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close file: %w", cerr)
+		}
+	}()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+
+	if err = encoder.Encode(cfg); err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+
+	return nil
+}
+
+// InitDefaultConfig uses the same tag format as every other neko release system
+func InitDefaultConfig() *Config {
+	return &Config{
+		Branches:  []string{"main", "master"},
+		TagFormat: "v${version}",
+		Plugins: []string{
+			"@semantic-release/commit-analyzer",
+			"@semantic-release/release-notes-generator",
+			"@semantic-release/github",
+		},
+	}
+}
@@ -0,0 +1,279 @@
+// Package semanticrelease includes the semantic-release release-system logic
+package semanticrelease
+
+/*
+@Author     Benjamin Senekowitsch
+@Contact    senekowitsch@nekoman.at
+@Since      19.10.2026
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/nekoman-hq/neko-cli/internal/ci"
+	"github.com/nekoman-hq/neko-cli/internal/config"
+	"github.com/nekoman-hq/neko-cli/internal/errors"
+	"github.com/nekoman-hq/neko-cli/internal/git"
+	"github.com/nekoman-hq/neko-cli/internal/log"
+	"github.com/nekoman-hq/neko-cli/internal/release"
+)
+
+var nextVersionRegex = regexp.MustCompile(`next release version is (\S+)`)
+
+// SemanticRelease lets semantic-release compute the version from the commit
+// history. Neko validates the computed version before anything is published.
+type SemanticRelease struct {
+	release.ToolBase
+	// next caches the version of the dry run, Survey and Release share it
+	next *semver.Version
+}
+
+func (s *SemanticRelease) Name() string {
+	return "semantic-release"
+}
+
+func (s *SemanticRelease) Init(_ *config.NekoConfig) error {
+	s.RequireBinary("npx")
+	s.runSemanticReleaseInit()
+	s.runSemanticReleaseCheck()
+
+	return nil
+}
+
+func (s *SemanticRelease) Release(v *semver.Version) error {
+	s.RequireBinary("npx")
+	next, err := s.nextVersion()
+	if err != nil {
+		errors.Fatal(
+			"semantic-release dry run failed",
+			err.Error(),
+			errors.ErrSemanticReleaseExecution,
+		)
+	}
+
+	if !next.Equal(v) {
+		errors.Fatal(
+			"Version violation",
+			fmt.Sprintf(
+				"semantic-release computed version %s but neko resolved %s.\nRun 'neko release' without a type to use the computed version.",
+				next,
+				v,
+			),
+			errors.ErrVersionViolation,
+		)
+	}
+
+	if err := s.CreateReleaseCommit(v); err != nil {
+		return err
+	}
+
+	if err := s.PushCommits(); err != nil {
+		return err
+	}
+
+	// semantic-release creates and pushes the tag, post-tag hooks run afterwards
+	if err := s.runSemanticRelease(); err != nil {
+		return err
+	}
+
+	s.RunHook(config.HookPostTag)
+	return nil
+}
+
+// SupportsPullRequest is false, semantic-release tags HEAD itself instead of
+// the merge commit of the release pull request
+func (s *SemanticRelease) SupportsPullRequest() bool {
+	return false
+}
+
+// Survey derives the release type from the version semantic-release computes
+func (s *SemanticRelease) Survey(v *semver.Version) (release.Type, error) {
+	next, err := s.nextVersion()
+	if err != nil {
+		return release.Patch, err
+	}
+
+	var rt release.Type
+	switch {
+	case next.Major() > v.Major():
+		rt = release.Major
+	case next.Minor() > v.Minor():
+		rt = release.Minor
+	case next.Patch() > v.Patch():
+		rt = release.Patch
+	default:
+		return release.Patch, fmt.Errorf("semantic-release computed %s which is not greater than %s", next, v)
+	}
+
+	log.Print(log.Release,
+		"semantic-release computed %s (%s)",
+		log.ColorText(log.ColorCyan, next.String()),
+		log.ColorText(log.ColorPurple, string(rt)),
+	)
+	return rt, nil
+}
+
+func (s *SemanticRelease) SupportsSurvey() bool {
+	return true
+}
+
+func (s *SemanticRelease) runSemanticReleaseInit() {
+	if _, err := os.Stat(configFile); err == nil {
+		log.Print(
+			log.Init,
+			"Skipping semantic-release init, %s already exists",
+			log.ColorText(log.ColorCyan, configFile),
+		)
+		return
+	} else if !os.IsNotExist(err) {
+		errors.Fatal(
+			"Failed to check "+configFile,
+			err.Error(),
+			errors.ErrFileAccess,
+		)
+		return
+	}
+
+	s.RequireBinary("npm")
+	log.V(log.Init,
+		fmt.Sprintf("Initializing semantic-release: %s",
+			log.ColorText(log.ColorGreen, "npm install -D semantic-release"),
+		),
+	)
+
+	cmd := exec.Command("npm", "install", "-D", "semantic-release")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"Failed to initialize semantic-release",
+			fmt.Sprintf("Command failed: %s\nOutput: %s", err.Error(), string(output)),
+			errors.ErrDependencyMissing,
+		)
+	}
+
+	if err := SaveConfig(InitDefaultConfig()); err != nil {
+		errors.Fatal("Failed to save "+configFile, err.Error(), errors.ErrFileAccess)
+	}
+
+	log.Print(
+		log.Init,
+		"\uF00C  Successfully initialized %s",
+		log.ColorText(log.ColorCyan, "semantic-release"),
+	)
+}
+
+func (s *SemanticRelease) runSemanticReleaseCheck() {
+	log.V(log.Init,
+		fmt.Sprintf("Verifying semantic-release installation: %s",
+			log.ColorText(log.ColorGreen, "npx semantic-release --version"),
+		),
+	)
+
+	cmd := exec.Command("npx", "semantic-release", "--version")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"Failed to verify semantic-release installation",
+			fmt.Sprintf("Command failed: %s\nOutput: %s", err.Error(), string(output)),
+			errors.ErrDependencyMissing,
+		)
+	}
+
+	log.Print(
+		log.Init,
+		"\uF00C  Successfully verified %s installation ( version: %s )",
+		log.ColorText(log.ColorCyan, "semantic-release"),
+		log.ColorText(log.ColorGreen, strings.TrimSpace(string(output))),
+	)
+}
+
+// nextVersion runs semantic-release in dry-run mode and parses the version it
+// would release. The dry run is slow, its result is reused.
+func (s *SemanticRelease) nextVersion() (*semver.Version, error) {
+	if s.next != nil {
+		return s.next, nil
+	}
+
+	repository, err := repositoryArgs()
+	if err != nil {
+		return nil, err
+	}
+	args := append(append([]string{"semantic-release", "--dry-run"}, repository...), ciArgs()...)
+
+	log.V(log.Release, fmt.Sprintf("Computing next version: %s",
+		log.ColorText(log.ColorGreen, "npx "+strings.Join(args, " "))))
+
+	cmd := exec.Command("npx", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("semantic-release --dry-run failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	m := nextVersionRegex.FindStringSubmatch(string(output))
+	if len(m) != 2 {
+		return nil, fmt.Errorf("semantic-release found no relevant changes, there is nothing to release")
+	}
+
+	next, err := semver.NewVersion(m[1])
+	if err != nil {
+		return nil, fmt.Errorf("semantic-release computed an invalid version %s", m[1])
+	}
+
+	log.V(log.Release, fmt.Sprintf("semantic-release next version: %s",
+		log.ColorText(log.ColorCyan, next.String())))
+	s.next = next
+	return next, nil
+}
+
+// runSemanticRelease creates the tag and the hosted release
+func (s *SemanticRelease) runSemanticRelease() error {
+	repository, err := repositoryArgs()
+	if err != nil {
+		return err
+	}
+	args := append(append([]string{"semantic-release"}, repository...), ciArgs()...)
+
+	log.V(log.Release, fmt.Sprintf("Running semantic-release: %s",
+		log.ColorText(log.ColorGreen, "npx "+strings.Join(args, " "))))
+
+	cmd := exec.Command("npx", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		errors.Fatal(
+			"semantic-release failed",
+			fmt.Sprintf("npx semantic-release failed: %s", strings.TrimSpace(string(output))),
+			errors.ErrSemanticReleaseExecution,
+		)
+	}
+
+	log.Print(log.Release, "\uF00C semantic-release %s",
+		log.ColorText(log.ColorGreen, "successful"))
+	return nil
+}
+
+// repositoryArgs points semantic-release at the release remote, otherwise it
+// pushes to origin or the repository of package.json
+func repositoryArgs() ([]string, error) {
+	url, err := git.PushURL()
+	if err != nil {
+		return nil, err
+	}
+	return []string{"--repository-url", url}, nil
+}
+
+// ciArgs disables the CI check of semantic-release when running locally
+func ciArgs() []string {
+	if ci.IsCI() {
+		return nil
+	}
+	return []string{"--no-ci"}
+}
+
+func init() {
+	release.Register(&SemanticRelease{})
+}